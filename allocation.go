@@ -0,0 +1,264 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+)
+
+// AllocationParty describes a single party of an allocation as seen by a RemainderStrategy.
+type AllocationParty struct {
+	// Weight is the ratio the party was allocated by.
+	Weight *big.Rat
+	// Amount is the allocated amount in minor units before leftovers are distributed.
+	Amount int64
+	// Remainder is the fraction of a minor unit the party lost to rounding.
+	Remainder *big.Rat
+}
+
+// RemainderStrategy decides how leftover pennies of an allocation are distributed amongst the parties.
+// It receives the number of leftover pennies and the parties, and returns the number of pennies
+// each party receives. Returned values must not be negative and must sum to leftover.
+type RemainderStrategy func(leftover int64, parties []AllocationParty) []int64
+
+var (
+	// RemainderRoundRobin distributes leftover pennies one by one starting from the first party.
+	RemainderRoundRobin RemainderStrategy = remainderRoundRobin
+	// RemainderLargest distributes leftover pennies one by one to the parties which lost the
+	// largest fractions to rounding (largest remainder or Hamilton method).
+	RemainderLargest RemainderStrategy = remainderLargest
+	// RemainderToLast gives all leftover pennies to the last party with a non zero ratio.
+	RemainderToLast RemainderStrategy = remainderToLast
+	// RemainderToLargestShare gives all leftover pennies to the party with the largest ratio.
+	RemainderToLargestShare RemainderStrategy = remainderToLargestShare
+)
+
+// RemainderRandom returns strategy which distributes leftover pennies one by one to parties
+// with a non zero ratio in random order. The same seed always produces the same distribution.
+func RemainderRandom(seed int64) RemainderStrategy {
+	return func(leftover int64, parties []AllocationParty) []int64 {
+		var order []int
+		for i, p := range parties {
+			if p.Weight.Sign() > 0 {
+				order = append(order, i)
+			}
+		}
+
+		r := rand.New(rand.NewSource(seed))
+		r.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+
+		return distributeInOrder(leftover, len(parties), order)
+	}
+}
+
+func remainderRoundRobin(leftover int64, parties []AllocationParty) []int64 {
+	order := make([]int, len(parties))
+	for i := range order {
+		order[i] = i
+	}
+
+	return distributeInOrder(leftover, len(parties), order)
+}
+
+func remainderLargest(leftover int64, parties []AllocationParty) []int64 {
+	order := make([]int, len(parties))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return parties[order[i]].Remainder.Cmp(parties[order[j]].Remainder) > 0
+	})
+
+	return distributeInOrder(leftover, len(parties), order)
+}
+
+func remainderToLast(leftover int64, parties []AllocationParty) []int64 {
+	ds := make([]int64, len(parties))
+	for i := len(parties) - 1; i >= 0; i-- {
+		if parties[i].Weight.Sign() > 0 {
+			ds[i] = leftover
+			break
+		}
+	}
+
+	return ds
+}
+
+func remainderToLargestShare(leftover int64, parties []AllocationParty) []int64 {
+	l := 0
+	for i, p := range parties {
+		if p.Weight.Cmp(parties[l].Weight) > 0 {
+			l = i
+		}
+	}
+
+	ds := make([]int64, len(parties))
+	ds[l] = leftover
+
+	return ds
+}
+
+// distributeInOrder hands out leftover pennies one by one to parties listed in order.
+func distributeInOrder(leftover int64, n int, order []int) []int64 {
+	ds := make([]int64, n)
+	if len(order) == 0 {
+		return ds
+	}
+
+	for p := 0; leftover != 0; p++ {
+		ds[order[p%len(order)]]++
+		leftover--
+	}
+
+	return ds
+}
+
+// AllocateWith works as Allocate but distributes leftover pennies using given strategy. It is a
+// separate method because ratios of Allocate are variadic and can't be followed by an option.
+func (m *Money) AllocateWith(s RemainderStrategy, rs ...int) ([]*Money, error) {
+	if len(rs) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	ws := make([]*big.Rat, len(rs))
+	for i, r := range rs {
+		if r < 0 {
+			return nil, fmt.Errorf("%w: ratio %d is %d", ErrInvalidRatio, i, r)
+		}
+
+		ws[i] = new(big.Rat).SetInt64(int64(r))
+	}
+
+	return m.allocate(ws, s)
+}
+
+// AllocateWeights returns slice of Money structs with split Self Value in given weights.
+// Weights may be any non negative rational numbers, e.g. big.NewRat(1, 3), leftover pennies
+// are distributed round-robin amongst the parties.
+func (m *Money) AllocateWeights(ws []*big.Rat) ([]*Money, error) {
+	return m.AllocateWeightsWith(RemainderRoundRobin, ws)
+}
+
+// AllocateWeightsWith works as AllocateWeights but distributes leftover pennies using given strategy.
+func (m *Money) AllocateWeightsWith(s RemainderStrategy, ws []*big.Rat) ([]*Money, error) {
+	if len(ws) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	for i, w := range ws {
+		if w == nil {
+			return nil, fmt.Errorf("%w: weight %d is nil", ErrInvalidRatio, i)
+		}
+
+		if w.Sign() < 0 {
+			return nil, fmt.Errorf("%w: weight %d is %s", ErrInvalidRatio, i, w.RatString())
+		}
+	}
+
+	return m.allocate(ws, s)
+}
+
+// AllocateByPercent returns slice of Money structs with split Self Value in given percentages,
+// e.g. AllocateByPercent("33.333", "33.333", "33.334"). Percentages may have a trailing "%" sign
+// and must sum to exactly 100.
+func (m *Money) AllocateByPercent(ps ...string) ([]*Money, error) {
+	if len(ps) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	sum := new(big.Rat)
+	ws := make([]*big.Rat, len(ps))
+	for i, p := range ps {
+		w, err := parsePercent(p)
+		if err != nil {
+			return nil, err
+		}
+
+		ws[i] = w
+		sum.Add(sum, w)
+	}
+
+	if sum.Cmp(big.NewRat(100, 1)) != 0 {
+		return nil, fmt.Errorf("%w: percentages must sum to 100, got %s", ErrInvalidPercentage, sum.FloatString(3))
+	}
+
+	return m.AllocateWeights(ws)
+}
+
+// AllocateProRata returns slice of Money structs with split Self Value proportionally to given
+// Money amounts, e.g. to spread a shipping fee over invoice lines. Amounts must be in the currency
+// of Self and must not be negative.
+func (m *Money) AllocateProRata(ms []*Money) ([]*Money, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	ws := make([]*big.Rat, len(ms))
+	for i, om := range ms {
+		if err := m.assertSameCurrencyData(om); err != nil {
+			return nil, err
+		}
+
+		ws[i] = new(big.Rat).SetInt64(om.Amount())
+	}
+
+	return m.AllocateWeights(ws)
+}
+
+// allocate splits Self Value by given weights and distributes leftover pennies using given strategy.
+func (m *Money) allocate(ws []*big.Rat, s RemainderStrategy) ([]*Money, error) {
+	sum := new(big.Rat)
+	for _, w := range ws {
+		sum.Add(sum, w)
+	}
+
+	if sum.Sign() == 0 {
+		return nil, ErrZeroRatios
+	}
+
+	var total int64
+	amount := new(big.Rat).SetInt64(m.Amount())
+	parties := make([]AllocationParty, len(ws))
+	for i, w := range ws {
+		exact := new(big.Rat).Mul(amount, w)
+		exact.Quo(exact, sum)
+
+		a := new(big.Int).Quo(exact.Num(), exact.Denom())
+		rem := new(big.Rat).Sub(exact, new(big.Rat).SetInt(a))
+
+		parties[i] = AllocationParty{Weight: w, Amount: a.Int64(), Remainder: rem.Abs(rem)}
+		total += parties[i].Amount
+	}
+
+	// Calculate leftover Value and let the strategy distribute it.
+	lo := m.Amount() - total
+	sub := int64(1)
+	if lo < 0 {
+		sub = -sub
+	}
+
+	ds := s(lo*sub, parties)
+	if len(ds) != len(parties) {
+		return nil, fmt.Errorf("%w: got %d parties, expected %d", ErrInvalidRemainder, len(ds), len(parties))
+	}
+
+	ms := make([]*Money, len(parties))
+	for i, p := range parties {
+		if ds[i] < 0 {
+			return nil, fmt.Errorf("%w: party %d got negative pennies", ErrInvalidRemainder, i)
+		}
+
+		ms[i] = &Money{AmountData: &Amount{p.Amount + ds[i]*sub}, CurrencyData: m.currency()}
+		lo -= ds[i] * sub
+	}
+
+	if lo != 0 {
+		return nil, ErrInvalidRemainder
+	}
+
+	return ms, nil
+}
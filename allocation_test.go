@@ -0,0 +1,283 @@
+package money
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+func TestMoney_AllocateWith(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		strategy   RemainderStrategy
+		ratios     []int
+		expected   []int64
+	}{
+		{100, RemainderRoundRobin, []int{1, 1, 1}, []int64{34, 33, 33}},
+		{100, RemainderToLast, []int{1, 1, 1}, []int64{33, 33, 34}},
+		{101, RemainderToLast, []int{1, 1, 1}, []int64{33, 33, 35}},
+		{101, RemainderToLargestShare, []int{1, 3, 1}, []int64{20, 61, 20}},
+		{100, RemainderLargest, []int{1, 1, 1}, []int64{34, 33, 33}},
+		{10, RemainderLargest, []int{20, 35, 45}, []int64{2, 4, 4}},
+		{-10, RemainderLargest, []int{20, 35, 45}, []int64{-2, -4, -4}},
+		{100, RemainderLargest, []int{10, 26, 64}, []int64{10, 26, 64}},
+		{10, RemainderLargest, []int{26, 26, 48}, []int64{3, 2, 5}},
+		{-100, RemainderToLast, []int{1, 1, 1}, []int64{-33, -33, -34}},
+		{101, RemainderToLast, []int{1, 1, 1, 0}, []int64{33, 33, 35, 0}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, "EUR")
+		var rs []int64
+		parties, err := m.AllocateWith(tc.strategy, tc.ratios...)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		for _, party := range parties {
+			rs = append(rs, party.AmountData.Val)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v", tc.AmountData, tc.ratios,
+				tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_AllocateWithRandom(t *testing.T) {
+	m := New(1000, "EUR")
+	a, err := m.AllocateWith(RemainderRandom(42), 1, 1, 1, 1, 1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := m.AllocateWith(RemainderRandom(42), 1, 1, 1, 1, 1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Expected same seed to give same allocation got %v and %v", a, b)
+	}
+}
+
+func TestMoney_AllocateWithCallback(t *testing.T) {
+	m := New(100, "EUR")
+	middle := func(leftover int64, parties []AllocationParty) []int64 {
+		ds := make([]int64, len(parties))
+		ds[len(parties)/2] = leftover
+		return ds
+	}
+
+	parties, err := m.AllocateWith(middle, 1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parties[1].Amount() != 34 {
+		t.Errorf("Expected %d got %d", 34, parties[1].Amount())
+	}
+}
+
+func TestMoney_AllocateWith2(t *testing.T) {
+	m := New(100, "EUR")
+	broken := func(leftover int64, parties []AllocationParty) []int64 {
+		return make([]int64, len(parties))
+	}
+
+	tcs := []struct {
+		strategy RemainderStrategy
+		ratios   []int
+	}{
+		{RemainderRoundRobin, nil},
+		{RemainderRoundRobin, []int{0, 0}},
+		{RemainderRoundRobin, []int{1, -1}},
+		{broken, []int{1, 1, 1}},
+	}
+
+	for _, tc := range tcs {
+		r, err := m.AllocateWith(tc.strategy, tc.ratios...)
+
+		if r != nil || err == nil {
+			t.Errorf("Expected err for ratios %v", tc.ratios)
+		}
+	}
+}
+
+func TestMoney_AllocateWithSumProperty(t *testing.T) {
+	strategies := []RemainderStrategy{
+		RemainderRoundRobin,
+		RemainderLargest,
+		RemainderToLast,
+		RemainderToLargestShare,
+		RemainderRandom(7),
+	}
+
+	for i, s := range strategies {
+		f := func(amount int64, ratios []uint16) bool {
+			rs := []int{1}
+			for _, r := range ratios {
+				rs = append(rs, int(r))
+			}
+
+			parties, err := New(amount, "EUR").AllocateWith(s, rs...)
+			if err != nil {
+				return false
+			}
+
+			total := new(big.Int)
+			for _, p := range parties {
+				total.Add(total, big.NewInt(p.Amount()))
+			}
+
+			return total.Int64() == amount
+		}
+
+		if err := quick.Check(f, nil); err != nil {
+			t.Errorf("Strategy %d: %v", i, err)
+		}
+	}
+}
+
+func TestMoney_AllocateWeights(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		weights    []*big.Rat
+		expected   []int64
+	}{
+		{100, []*big.Rat{big.NewRat(1, 3), big.NewRat(1, 3), big.NewRat(1, 3)}, []int64{34, 33, 33}},
+		{1000, []*big.Rat{big.NewRat(1, 2), big.NewRat(1, 4)}, []int64{667, 333}},
+		{100, []*big.Rat{big.NewRat(0, 1), big.NewRat(3, 1)}, []int64{0, 100}},
+		{9223372036854775807, []*big.Rat{big.NewRat(9223372036854775807, 1), big.NewRat(9223372036854775807, 1)},
+			[]int64{4611686018427387904, 4611686018427387903}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, "EUR")
+		var rs []int64
+		parties, err := m.AllocateWeights(tc.weights)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		for _, party := range parties {
+			rs = append(rs, party.AmountData.Val)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for weights %v to be %v got %v", tc.AmountData, tc.weights,
+				tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_AllocateWeights2(t *testing.T) {
+	m := New(100, "EUR")
+	tcs := [][]*big.Rat{
+		nil,
+		{big.NewRat(1, 1), nil},
+		{big.NewRat(1, 1), big.NewRat(-1, 2)},
+		{big.NewRat(0, 1), big.NewRat(0, 1)},
+	}
+
+	for _, ws := range tcs {
+		r, err := m.AllocateWeights(ws)
+
+		if r != nil || err == nil {
+			t.Errorf("Expected err for weights %v", ws)
+		}
+	}
+}
+
+func TestMoney_AllocateByPercent(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		percents   []string
+		expected   []int64
+	}{
+		{100000, []string{"33.333", "33.333", "33.334"}, []int64{33333, 33333, 33334}},
+		{100, []string{"33.333%", "33.333%", "33.334%"}, []int64{34, 33, 33}},
+		{1000, []string{"12.5", "87.5"}, []int64{125, 875}},
+		{1000, []string{"100"}, []int64{1000}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, "EUR")
+		var rs []int64
+		parties, err := m.AllocateByPercent(tc.percents...)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		for _, party := range parties {
+			rs = append(rs, party.AmountData.Val)
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %d for percentages %v to be %v got %v", tc.AmountData, tc.percents,
+				tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_AllocateByPercent2(t *testing.T) {
+	m := New(100, "EUR")
+	tcs := [][]string{
+		nil,
+		{"50", "40"},
+		{"50", "fifty"},
+		{"150", "-50"},
+	}
+
+	for _, ps := range tcs {
+		r, err := m.AllocateByPercent(ps...)
+
+		if r != nil || err == nil {
+			t.Errorf("Expected err for percentages %v", ps)
+		}
+	}
+}
+
+func TestMoney_AllocateProRata(t *testing.T) {
+	shipping := New(1000, "EUR")
+	lines := []*Money{New(2999, "EUR"), New(1500, "EUR"), New(501, "EUR")}
+	parties, err := shipping.AllocateProRata(lines)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rs []int64
+	for _, party := range parties {
+		rs = append(rs, party.Amount())
+	}
+
+	expected := []int64{600, 300, 100}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Expected pro rata allocation %v got %v", expected, rs)
+	}
+}
+
+func TestMoney_AllocateProRata2(t *testing.T) {
+	m := New(100, "EUR")
+	tcs := [][]*Money{
+		nil,
+		{New(100, "EUR"), New(100, "USD")},
+		{New(100, "USD"), New(100, "USD")},
+		{New(100, "EUR"), New(-100, "EUR")},
+		{New(0, "EUR")},
+	}
+
+	for _, ms := range tcs {
+		r, err := m.AllocateProRata(ms)
+
+		if r != nil || err == nil {
+			t.Errorf("Expected err for amounts %v", ms)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Package amortization generates loan repayment schedules on top of money.Money.
+//
+// Annuity loans repay equal payments, equal principal loans repay equal parts of the principal
+// and interest only loans repay the whole principal with the last payment. Interest of every
+// period is rounded to minor units and the final payment absorbs the rounding, so the balance
+// always ends exactly at zero.
+package amortization
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/interest"
+	"github.com/Sinojin/go-money/internal/amount"
+	"github.com/Sinojin/go-money/internal/percent"
+)
+
+var (
+	// ErrInvalidRate is returned when a rate percentage can't be parsed or is negative.
+	ErrInvalidRate = errors.New("invalid interest rate")
+	// ErrInvalidTerm is returned when a loan has no payments.
+	ErrInvalidTerm = errors.New("term must be at least one payment")
+	// ErrInvalidFrequency is returned when a loan has no payments per year.
+	ErrInvalidFrequency = errors.New("invalid frequency")
+	// ErrInvalidMethod is returned for unknown amortization methods.
+	ErrInvalidMethod = errors.New("invalid amortization method")
+)
+
+// Method is a way of repaying the principal.
+type Method int
+
+const (
+	// Annuity repays equal payments, with interest decreasing and principal increasing over time.
+	Annuity Method = iota
+	// EqualPrincipal repays equal parts of the principal plus interest of the period.
+	EqualPrincipal
+	// InterestOnly repays interest of every period and the whole principal with the last payment.
+	InterestOnly
+)
+
+// Loan describes a loan to amortize.
+type Loan struct {
+	// Principal is the borrowed amount.
+	Principal *money.Money
+	// Rate is the nominal annual rate in percent, e.g. "5" or "4.25%".
+	Rate string
+	// Term is the number of payments.
+	Term int
+	// Frequency is the number of payments per year, the rate of a period is Rate divided by Frequency.
+	Frequency interest.Frequency
+	// Method of repaying the principal.
+	Method Method
+	// Mode is used to round interest and payments to minor units.
+	Mode money.RoundingMode
+	// Start is the date of the loan, rows are dated when given.
+	Start time.Time
+}
+
+// Row is a single payment of the schedule.
+type Row struct {
+	// Period is the number of the payment starting from 1.
+	Period    int
+	Date      time.Time
+	Payment   *money.Money
+	Interest  *money.Money
+	Principal *money.Money
+	// Balance is the principal left after the payment.
+	Balance *money.Money
+}
+
+// Schedule is the repayment schedule of a loan.
+type Schedule struct {
+	Rows          []Row
+	TotalPayment  *money.Money
+	TotalInterest *money.Money
+}
+
+// Generate returns the repayment schedule of the loan.
+func Generate(l Loan) (*Schedule, error) {
+	if l.Term <= 0 {
+		return nil, ErrInvalidTerm
+	}
+
+	if l.Frequency == interest.AtMaturity || !l.Frequency.Valid() {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidFrequency, l.Frequency)
+	}
+
+	if l.Principal.IsNegative() {
+		return nil, money.ErrNegativeAmount
+	}
+
+	rate, err := l.rate()
+	if err != nil {
+		return nil, err
+	}
+
+	code := l.Principal.Currency().Code
+	principals := make([]int64, l.Term)
+	var payment int64
+	switch l.Method {
+	case Annuity:
+		if payment, err = l.annuity(rate); err != nil {
+			return nil, err
+		}
+	case EqualPrincipal:
+		parts, err := l.Principal.Split(l.Term)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, p := range parts {
+			principals[i] = p.Amount()
+		}
+	case InterestOnly:
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMethod, l.Method)
+	}
+
+	s := &Schedule{}
+	balance := l.Principal.Amount()
+	var totalPayment, totalInterest int64
+	for i := 0; i < l.Term; i++ {
+		in, err := amount.Round(new(big.Rat).Mul(new(big.Rat).SetInt64(balance), rate), code, l.Mode)
+		if err != nil {
+			return nil, err
+		}
+
+		p := principals[i]
+		if l.Method == Annuity {
+			p = payment - in
+		}
+
+		// The last payment repays whatever is left.
+		if i == l.Term-1 || p > balance {
+			p = balance
+		}
+
+		balance -= p
+		row := Row{
+			Period:    i + 1,
+			Payment:   money.New(in+p, code),
+			Interest:  money.New(in, code),
+			Principal: money.New(p, code),
+			Balance:   money.New(balance, code),
+		}
+
+		if !l.Start.IsZero() {
+			row.Date = l.Frequency.Add(l.Start, i+1)
+		}
+
+		s.Rows = append(s.Rows, row)
+		totalPayment += in + p
+		totalInterest += in
+	}
+
+	s.TotalPayment = money.New(totalPayment, code)
+	s.TotalInterest = money.New(totalInterest, code)
+
+	return s, nil
+}
+
+// annuity returns the rounded regular payment P * r / (1 - (1 + r)^-n) for periodic rate r.
+func (l Loan) annuity(rate *big.Rat) (int64, error) {
+	p := new(big.Rat).SetInt64(l.Principal.Amount())
+	if rate.Sign() == 0 {
+		return amount.Round(p.Quo(p, big.NewRat(int64(l.Term), 1)), l.Principal.Currency().Code, l.Mode)
+	}
+
+	n := big.NewInt(int64(l.Term))
+	f := new(big.Rat).Add(big.NewRat(1, 1), rate)
+	f.SetFrac(new(big.Int).Exp(f.Num(), n, nil), new(big.Int).Exp(f.Denom(), n, nil))
+
+	p.Mul(p, rate).Mul(p, f)
+
+	return amount.Round(p.Quo(p, f.Sub(f, big.NewRat(1, 1))), l.Principal.Currency().Code, l.Mode)
+}
+
+// rate returns rate of a single period as a fraction, e.g. 0.005 for 6% paid monthly.
+func (l Loan) rate() (*big.Rat, error) {
+	r, ok := percent.Parse(l.Rate)
+	if !ok || r.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRate, l.Rate)
+	}
+
+	return r.Quo(r, big.NewRat(100*int64(l.Frequency), 1)), nil
+}
@@ -0,0 +1,195 @@
+package amortization
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/interest"
+)
+
+func TestGenerate_Annuity(t *testing.T) {
+	s, err := Generate(Loan{
+		Principal: money.New(10000000, "EUR"),
+		Rate:      "6",
+		Term:      12,
+		Frequency: interest.Monthly,
+		Method:    Annuity,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.Rows) != 12 {
+		t.Fatalf("Expected %d rows got %d", 12, len(s.Rows))
+	}
+
+	for i, r := range s.Rows[:11] {
+		if r.Payment.Amount() != 860664 {
+			t.Errorf("Expected payment %d to be %d got %d", i+1, 860664, r.Payment.Amount())
+		}
+	}
+
+	tcs := []struct {
+		period                                int
+		payment, interest, principal, balance int64
+	}{
+		{1, 860664, 50000, 810664, 9189336},
+		{12, 860669, 4282, 856387, 0},
+	}
+
+	for _, tc := range tcs {
+		r := s.Rows[tc.period-1]
+		if r.Payment.Amount() != tc.payment || r.Interest.Amount() != tc.interest ||
+			r.Principal.Amount() != tc.principal || r.Balance.Amount() != tc.balance {
+			t.Errorf("Expected row %d to be %d = %d + %d, %d left got %d = %d + %d, %d left", tc.period, tc.payment,
+				tc.interest, tc.principal, tc.balance, r.Payment.Amount(), r.Interest.Amount(), r.Principal.Amount(),
+				r.Balance.Amount())
+		}
+	}
+
+	if s.TotalInterest.Amount() != 327973 || s.TotalPayment.Amount() != 10327973 {
+		t.Errorf("Expected totals %d and %d got %d and %d", 10327973, 327973, s.TotalPayment.Amount(),
+			s.TotalInterest.Amount())
+	}
+}
+
+func TestGenerate_EqualPrincipal(t *testing.T) {
+	s, err := Generate(Loan{
+		Principal: money.New(100000, "USD"),
+		Rate:      "12",
+		Term:      3,
+		Frequency: interest.Monthly,
+		Method:    EqualPrincipal,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		period                                int
+		payment, interest, principal, balance int64
+	}{
+		{1, 34334, 1000, 33334, 66666},
+		{2, 34000, 667, 33333, 33333},
+		{3, 33666, 333, 33333, 0},
+	}
+
+	for _, tc := range tcs {
+		r := s.Rows[tc.period-1]
+		if r.Payment.Amount() != tc.payment || r.Interest.Amount() != tc.interest ||
+			r.Principal.Amount() != tc.principal || r.Balance.Amount() != tc.balance {
+			t.Errorf("Expected row %d to be %d = %d + %d, %d left got %d = %d + %d, %d left", tc.period, tc.payment,
+				tc.interest, tc.principal, tc.balance, r.Payment.Amount(), r.Interest.Amount(), r.Principal.Amount(),
+				r.Balance.Amount())
+		}
+	}
+}
+
+func TestGenerate_InterestOnly(t *testing.T) {
+	s, err := Generate(Loan{
+		Principal: money.New(100000, "USD"),
+		Rate:      "5",
+		Term:      4,
+		Frequency: interest.Quarterly,
+		Method:    InterestOnly,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		period                                int
+		payment, interest, principal, balance int64
+	}{
+		{1, 1250, 1250, 0, 100000},
+		{2, 1250, 1250, 0, 100000},
+		{3, 1250, 1250, 0, 100000},
+		{4, 101250, 1250, 100000, 0},
+	}
+
+	for _, tc := range tcs {
+		r := s.Rows[tc.period-1]
+		if r.Payment.Amount() != tc.payment || r.Interest.Amount() != tc.interest ||
+			r.Principal.Amount() != tc.principal || r.Balance.Amount() != tc.balance {
+			t.Errorf("Expected row %d to be %d = %d + %d, %d left got %d = %d + %d, %d left", tc.period, tc.payment,
+				tc.interest, tc.principal, tc.balance, r.Payment.Amount(), r.Interest.Amount(), r.Principal.Amount(),
+				r.Balance.Amount())
+		}
+	}
+}
+
+func TestGenerate_EndsAtZero(t *testing.T) {
+	for _, m := range []Method{Annuity, EqualPrincipal, InterestOnly} {
+		for _, term := range []int{1, 7, 60, 360} {
+			for _, rate := range []string{"0", "3.333", "19.99"} {
+				l := Loan{Principal: money.New(12345678, "EUR"), Rate: rate, Term: term,
+					Frequency: interest.Monthly, Method: m}
+				s, err := Generate(l)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var principal int64
+				for _, r := range s.Rows {
+					principal += r.Principal.Amount()
+					if r.Payment.Amount() != r.Interest.Amount()+r.Principal.Amount() || r.Principal.IsNegative() {
+						t.Errorf("Expected %+v row %d payment to be interest + principal got %d = %d + %d", l, r.Period,
+							r.Payment.Amount(), r.Interest.Amount(), r.Principal.Amount())
+					}
+				}
+
+				if last := s.Rows[len(s.Rows)-1]; !last.Balance.IsZero() || principal != 12345678 {
+					t.Errorf("Expected %+v to repay %d and end at zero got %d and %d", l, 12345678, principal,
+						last.Balance.Amount())
+				}
+			}
+		}
+	}
+}
+
+func TestGenerate_Dates(t *testing.T) {
+	s, err := Generate(Loan{
+		Principal: money.New(300, "EUR"),
+		Rate:      "0",
+		Term:      3,
+		Frequency: interest.Monthly,
+		Start:     time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, day := range []int{29, 31, 30} {
+		if d := s.Rows[i].Date; d.Month() != time.Month(i+2) || d.Day() != day {
+			t.Errorf("Expected row %d to be due on day %d of %s got %s", i+1, day, time.Month(i+2), d)
+		}
+	}
+
+	if s.Rows[0].Payment.Amount() != 100 {
+		t.Errorf("Expected payment %d got %d", 100, s.Rows[0].Payment.Amount())
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	tcs := []struct {
+		loan     Loan
+		expected error
+	}{
+		{Loan{Principal: money.New(100, "EUR"), Rate: "5", Frequency: interest.Monthly}, ErrInvalidTerm},
+		{Loan{Principal: money.New(100, "EUR"), Rate: "5", Term: 12}, ErrInvalidFrequency},
+		{Loan{Principal: money.New(100, "EUR"), Rate: "5", Term: 12, Frequency: 5}, ErrInvalidFrequency},
+		{Loan{Principal: money.New(100, "EUR"), Rate: "-5", Term: 12, Frequency: interest.Monthly}, ErrInvalidRate},
+		{Loan{Principal: money.New(100, "EUR"), Rate: "x", Term: 12, Frequency: interest.Monthly}, ErrInvalidRate},
+		{Loan{Principal: money.New(-100, "EUR"), Rate: "5", Term: 12, Frequency: interest.Monthly}, money.ErrNegativeAmount},
+		{Loan{Principal: money.New(100, "EUR"), Rate: "5", Term: 12, Frequency: interest.Monthly, Method: 9}, ErrInvalidMethod},
+	}
+
+	for _, tc := range tcs {
+		s, err := Generate(tc.loan)
+		if s != nil || !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v got %v", tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package money
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+)
+
+// Bag holds monetary Values in multiple currencies, e.g. totals of a cart with EUR and USD lines.
+// The zero value is an empty Bag ready to use.
+type Bag struct {
+	amounts map[string]*Money
+}
+
+// NewBag creates new Bag holding sum of given Money.
+func NewBag(ms ...*Money) (*Bag, error) {
+	b := &Bag{}
+	for _, m := range ms {
+		if err := b.Add(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// Add adds given Money to the Value held in its currency.
+func (b *Bag) Add(m *Money) error {
+	return b.add(m, big.NewInt(m.Amount()))
+}
+
+// Subtract subtracts given Money from the Value held in its currency.
+func (b *Bag) Subtract(m *Money) error {
+	// Negate in big.Int, -math.MinInt64 doesn't fit int64.
+	return b.add(m, new(big.Int).Neg(big.NewInt(m.Amount())))
+}
+
+func (b *Bag) add(m *Money, a *big.Int) error {
+	if b.amounts == nil {
+		b.amounts = make(map[string]*Money)
+	}
+
+	code := m.currency().Code
+	cur, ok := b.amounts[code]
+	if !ok {
+		cur = m.withAmount(0)
+	}
+
+	total := new(big.Int).Add(big.NewInt(cur.Amount()), a)
+	if !total.IsInt64() {
+		return ErrOverflow
+	}
+
+	if total.Sign() == 0 {
+		delete(b.amounts, code)
+		return nil
+	}
+
+	b.amounts[code] = cur.withAmount(total.Int64())
+
+	return nil
+}
+
+// Get returns Value held in currency with given code, zero if the Bag holds none.
+func (b *Bag) Get(code string) *Money {
+	c := newCurrency(code)
+	if m, ok := b.amounts[c.Code]; ok {
+		return m.withAmount(m.Amount())
+	}
+
+	return &Money{AmountData: &Amount{0}, CurrencyData: c.get()}
+}
+
+// Currencies returns sorted codes of currencies with a non zero Value.
+func (b *Bag) Currencies() []string {
+	codes := make([]string, 0, len(b.amounts))
+	for code := range b.amounts {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	return codes
+}
+
+// All returns non zero Values held by the Bag ordered by currency code.
+func (b *Bag) All() []*Money {
+	ms := make([]*Money, 0, len(b.amounts))
+	for _, code := range b.Currencies() {
+		ms = append(ms, b.Get(code))
+	}
+
+	return ms
+}
+
+// IsZero returns boolean of whether all Values held by the Bag are equal to zero.
+func (b *Bag) IsZero() bool {
+	return len(b.amounts) == 0
+}
+
+// Convert collapses the Bag into a single Money in currency with given code using rates of given
+// provider. Values are converted exactly and the total is rounded half up once.
+func (b *Bag) Convert(code string, rp RateProvider) (*Money, error) {
+	to := newCurrency(code).get()
+	total := new(big.Rat)
+	for _, m := range b.All() {
+		r, err := m.convertExact(to, rp)
+		if err != nil {
+			return nil, err
+		}
+
+		total.Add(total, r)
+	}
+
+	return newFromRat(total, to, RoundHalfUp)
+}
+
+// MarshalJSON is implementation of json.Marshaller. Bag is encoded as object of
+// currency codes and Values in minor units, e.g. {"EUR":1050,"USD":200}.
+func (b Bag) MarshalJSON() ([]byte, error) {
+	data := make(map[string]int64, len(b.amounts))
+	for code, m := range b.amounts {
+		data[code] = m.Amount()
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (b *Bag) UnmarshalJSON(data []byte) error {
+	amounts := make(map[string]int64)
+	if err := json.Unmarshal(data, &amounts); err != nil {
+		return err
+	}
+
+	nb := Bag{}
+	for code, a := range amounts {
+		if err := nb.Add(New(a, code)); err != nil {
+			return err
+		}
+	}
+
+	*b = nb
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestBag_Add(t *testing.T) {
+	b, err := NewBag(New(100, "EUR"), New(200, "USD"), New(50, "EUR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Get("EUR").Amount() != 150 {
+		t.Errorf("Expected %d got %d", 150, b.Get("EUR").Amount())
+	}
+
+	if b.Get("usd").Amount() != 200 {
+		t.Errorf("Expected %d got %d", 200, b.Get("usd").Amount())
+	}
+
+	if b.Get("GBP").Amount() != 0 || b.Get("GBP").Currency().Code != "GBP" {
+		t.Errorf("Expected zero GBP got %d %s", b.Get("GBP").Amount(), b.Get("GBP").Currency().Code)
+	}
+
+	if err := b.Add(New(math.MaxInt64, "EUR")); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestBag_Subtract(t *testing.T) {
+	var b Bag
+
+	if !b.IsZero() {
+		t.Error("Expected zero value Bag to be zero")
+	}
+
+	if err := b.Add(New(100, "EUR")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Subtract(New(300, "USD")); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual([]string{"EUR", "USD"}, b.Currencies()) {
+		t.Errorf("Expected currencies %v got %v", []string{"EUR", "USD"}, b.Currencies())
+	}
+
+	if b.Get("USD").Amount() != -300 {
+		t.Errorf("Expected %d got %d", -300, b.Get("USD").Amount())
+	}
+
+	if err := b.Subtract(New(100, "EUR")); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual([]string{"USD"}, b.Currencies()) {
+		t.Errorf("Expected currencies %v got %v", []string{"USD"}, b.Currencies())
+	}
+
+	if err := b.Add(New(300, "USD")); err != nil {
+		t.Fatal(err)
+	}
+
+	if !b.IsZero() {
+		t.Error("Expected Bag to be zero")
+	}
+
+	if err := b.Subtract(New(math.MinInt64, "EUR")); err != ErrOverflow || !b.IsZero() {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if err := b.Add(New(-1, "EUR")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Subtract(New(math.MinInt64, "EUR")); err != nil || b.Get("EUR").Amount() != math.MaxInt64 {
+		t.Errorf("Expected %d got %d %v", int64(math.MaxInt64), b.Get("EUR").Amount(), err)
+	}
+}
+
+func TestBag_All(t *testing.T) {
+	b, _ := NewBag(New(3, "USD"), New(1, "CHF"), New(2, "EUR"))
+
+	var rs []string
+	for _, m := range b.All() {
+		rs = append(rs, m.Display())
+	}
+
+	expected := []string{"0.01 CHF", "€0.02", "$0.03"}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Expected %v got %v", expected, rs)
+	}
+}
+
+func TestBag_Convert(t *testing.T) {
+	var rs Rates
+	rs.Set("EUR", "USD", big.NewRat(11, 10))
+	rs.Set("GBP", "USD", big.NewRat(13, 10))
+
+	b, _ := NewBag(New(1005, "EUR"), New(1005, "EUR"), New(1000, "GBP"), New(25, "USD"))
+	r, err := b.Convert("USD", &rs)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2010 * 1.1 + 1000 * 1.3 + 25 = 3536
+	if r.Amount() != 3536 || r.Currency().Code != "USD" {
+		t.Errorf("Expected %d USD got %d %s", 3536, r.Amount(), r.Currency().Code)
+	}
+
+	b, _ = NewBag(New(5, "EUR"), New(5, "EUR"))
+	rs.Set("EUR", "USD", big.NewRat(105, 100))
+	r, _ = b.Convert("USD", &rs)
+
+	if r.Amount() != 11 {
+		t.Errorf("Expected %d got %d", 11, r.Amount())
+	}
+
+	b, _ = NewBag(New(100, "JPY"))
+	if r, err := b.Convert("USD", &rs); r != nil || err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestBag_JSON(t *testing.T) {
+	b, _ := NewBag(New(1050, "EUR"), New(200, "USD"))
+	expected := `{"EUR":1050,"USD":200}`
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != expected {
+		t.Errorf("Expected %s got %s", expected, string(data))
+	}
+
+	var rb Bag
+	if err := json.Unmarshal(data, &rb); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(b.Currencies(), rb.Currencies()) || rb.Get("EUR").Amount() != 1050 {
+		t.Errorf("Expected %s got %v", expected, rb.All())
+	}
+
+	if err := json.Unmarshal([]byte(`{"EUR":"ten"}`), &rb); err == nil {
+		t.Error("Expected err")
+	}
+}
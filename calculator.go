@@ -1,6 +1,9 @@
 package money
 
-import "math"
+import (
+	"math"
+	"math/big"
+)
 
 type calculator struct{}
 
@@ -24,10 +27,6 @@ func (c *calculator) modulus(a *Amount, d int64) *Amount {
 	return &Amount{a.Val % d}
 }
 
-func (c *calculator) allocate(a *Amount, r, s int) *Amount {
-	return &Amount{a.Val * int64(r) / int64(s)}
-}
-
 func (c *calculator) absolute(a *Amount) *Amount {
 	if a.Val < 0 {
 		return &Amount{-a.Val}
@@ -49,21 +48,29 @@ func (c *calculator) round(a *Amount, e int) *Amount {
 		return &Amount{0}
 	}
 
-	absam := c.absolute(a)
+	absam := c.absolute(a).Val
 	exp := int64(math.Pow(10, float64(e)))
-	m := absam.Val % exp
+	m := absam % exp
 
 	if m > (exp / 2) {
-		absam.Val += exp
+		absam += exp
 	}
 
-	absam.Val = (absam.Val / exp) * exp
+	absam = (absam / exp) * exp
 
 	if a.Val < 0 {
-		a.Val = -absam.Val
-	} else {
-		a.Val = absam.Val
+		return &Amount{-absam}
 	}
 
-	return &Amount{a.Val}
+	return &Amount{absam}
+}
+
+func (c *calculator) roundIncrement(a *Amount, inc int64, mode RoundingMode) (*Amount, error) {
+	r := mode.quo(big.NewInt(a.Val), big.NewInt(inc))
+	r.Mul(r, big.NewInt(inc))
+	if !r.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return &Amount{r.Int64()}, nil
 }
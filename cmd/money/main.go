@@ -0,0 +1,330 @@
+// Command money formats, parses, splits, allocates and converts money from the command line.
+//
+// Amounts are given and printed in minor units of their currency, e.g. 123456 EUR is €1,234.56.
+//
+//	money format 123456 EUR
+//	money parse "1.234,56 €" --currency EUR
+//	money split 1000 GBP 3
+//	money allocate 1000 USD 1 2 3
+//	money convert 100 USD EUR --rates rates.csv
+//	money currencies --json
+//
+// Results of split, allocate and convert are formatted in their currency, use --minor to print
+// minor units instead. Rates files are CSV with from, to and rate columns.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/amount"
+	"github.com/Sinojin/go-money/moneycsv"
+)
+
+const usage = `usage: money <command> [arguments]
+
+commands:
+  format <amount> <currency>                   format minor units in the currency
+  parse <text> --currency <code>               parse formatted amount to minor units
+        [--decimal <sep>] [--thousand <sep>]
+  split <amount> <currency> <parties> [--minor] split amount in equal parts
+  allocate <amount> <currency> <ratio>... [--minor]
+                                               allocate amount by ratios
+  convert <amount> <from> <to> --rates <file> [--minor]
+                                               convert amount using rates of CSV file
+  currencies [--json]                          list known currencies
+`
+
+// errUsage is returned for invalid command lines.
+var errUsage = errors.New("invalid usage")
+
+// commands are the commands by name, they write results to the writer.
+var commands = map[string]func(args []string, w io.Writer) error{
+	"format":     format,
+	"parse":      parse,
+	"split":      split,
+	"allocate":   allocate,
+	"convert":    convert,
+	"currencies": list,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command line and returns exit code, 0 on success, 1 on errors and 2 on invalid usage.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "money: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+
+	if err := cmd(args[1:], stdout); err != nil {
+		fmt.Fprintf(stderr, "money %s: %v\n", args[0], err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(stderr, "\n%s", usage)
+			return 2
+		}
+
+		return 1
+	}
+
+	return 0
+}
+
+// parseFlags parses flags of fs which may be mixed with positional arguments and returns
+// the positional arguments. Negative numbers are positional arguments. It fails unless there
+// are n of them, or at least n when min is set.
+func parseFlags(fs *flag.FlagSet, args []string, n int, min bool) ([]string, error) {
+	fs.SetOutput(ioutil.Discard)
+	// Stop flag parsing before negative numbers so they are not taken for flags.
+	var rest []string
+	for _, arg := range args {
+		if isNegative(arg) {
+			rest = append(rest, "--")
+		}
+		rest = append(rest, arg)
+	}
+
+	var pos []string
+	for len(rest) > 0 {
+		if err := fs.Parse(rest); err != nil {
+			return nil, fmt.Errorf("%w: %v", errUsage, err)
+		}
+
+		rest = fs.Args()
+		if len(rest) > 0 {
+			pos, rest = append(pos, rest[0]), rest[1:]
+		}
+	}
+
+	if len(pos) < n || !min && len(pos) > n {
+		return nil, fmt.Errorf("%w: %d arguments given", errUsage, len(pos))
+	}
+
+	return pos, nil
+}
+
+// isNegative reports whether argument is a negative number rather than a flag.
+func isNegative(arg string) bool {
+	return len(arg) > 1 && arg[0] == '-' && arg[1] >= '0' && arg[1] <= '9'
+}
+
+// newMoney returns Money of amount in minor units and currency code arguments.
+func newMoney(units, code string) (*money.Money, error) {
+	c, err := amount.Currency(code)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := strconv.ParseInt(units, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", money.ErrInvalidAmount, units)
+	}
+
+	return money.New(a, c.Code), nil
+}
+
+// write writes every Money on its own line, in minor units when minor is set.
+func write(w io.Writer, minor bool, ms ...*money.Money) error {
+	for _, m := range ms {
+		s := m.Display()
+		if minor {
+			s = strconv.FormatInt(m.Amount(), 10)
+		}
+
+		if _, err := fmt.Fprintln(w, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func format(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("format", flag.ContinueOnError)
+	pos, err := parseFlags(fs, args, 2, false)
+	if err != nil {
+		return err
+	}
+
+	m, err := newMoney(pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+
+	return write(w, false, m)
+}
+
+func parse(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("parse", flag.ContinueOnError)
+	code := fs.String("currency", "", "currency code")
+	dec := fs.String("decimal", "", "decimal separator, detected when empty")
+	thousand := fs.String("thousand", "", "thousand separator, detected when empty")
+	pos, err := parseFlags(fs, args, 1, false)
+	if err != nil {
+		return err
+	}
+
+	if *code == "" {
+		return fmt.Errorf("%w: --currency is required", errUsage)
+	}
+
+	c, err := amount.Currency(*code)
+	if err != nil {
+		return err
+	}
+
+	a, err := amount.Parse(pos[0], c, *dec, *thousand)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, a)
+
+	return err
+}
+
+func split(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("split", flag.ContinueOnError)
+	minor := fs.Bool("minor", false, "print minor units")
+	pos, err := parseFlags(fs, args, 3, false)
+	if err != nil {
+		return err
+	}
+
+	m, err := newMoney(pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+
+	n, err := strconv.Atoi(pos[2])
+	if err != nil {
+		return fmt.Errorf("%w: %q", money.ErrInvalidSplit, pos[2])
+	}
+
+	ms, err := m.Split(n)
+	if err != nil {
+		return err
+	}
+
+	return write(w, *minor, ms...)
+}
+
+func allocate(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("allocate", flag.ContinueOnError)
+	minor := fs.Bool("minor", false, "print minor units")
+	pos, err := parseFlags(fs, args, 3, true)
+	if err != nil {
+		return err
+	}
+
+	m, err := newMoney(pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+
+	rs := make([]int, len(pos)-2)
+	for i, s := range pos[2:] {
+		if rs[i], err = strconv.Atoi(s); err != nil {
+			return fmt.Errorf("%w: %q", money.ErrInvalidRatio, s)
+		}
+	}
+
+	ms, err := m.Allocate(rs...)
+	if err != nil {
+		return err
+	}
+
+	return write(w, *minor, ms...)
+}
+
+func convert(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	path := fs.String("rates", "", "CSV file with from, to and rate columns")
+	minor := fs.Bool("minor", false, "print minor units")
+	pos, err := parseFlags(fs, args, 3, false)
+	if err != nil {
+		return err
+	}
+
+	if *path == "" {
+		return fmt.Errorf("%w: --rates is required", errUsage)
+	}
+
+	m, err := newMoney(pos[0], pos[1])
+	if err != nil {
+		return err
+	}
+
+	to, err := amount.Currency(pos[2])
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rates, err := moneycsv.ReadRates(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *path, err)
+	}
+
+	c, err := m.Convert(to.Code, rates)
+	if err != nil {
+		return err
+	}
+
+	return write(w, *minor, c)
+}
+
+func list(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("currencies", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "print JSON")
+	if _, err := parseFlags(fs, args, 0, false); err != nil {
+		return err
+	}
+
+	cs := money.Currencies()
+	if *asJSON {
+		js := make([]amount.CurrencyJSON, len(cs))
+		for i := range cs {
+			js[i] = amount.NewCurrencyJSON(&cs[i])
+		}
+
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+
+		return e.Encode(js)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Code\tFraction\tGrapheme\tExample")
+	for _, c := range cs {
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", c.Code, c.Fraction, c.Grapheme, money.New(123456, c.Code).Display())
+	}
+
+	return tw.Flush()
+}
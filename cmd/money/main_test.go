@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Sinojin/go-money/internal/amount"
+)
+
+func TestRun(t *testing.T) {
+	f, err := ioutil.TempFile("", "rates*.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString("from,to,rate\nUSD,EUR,0.92\nEUR,GBP,0.85\n"); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	tcs := []struct {
+		args     []string
+		code     int
+		expected string
+	}{
+		{[]string{"format", "123456", "EUR"}, 0, "€1,234.56\n"},
+		{[]string{"format", "-5", "usd"}, 0, "-$0.05\n"},
+		{[]string{"format", "500", "JPY"}, 0, "¥500\n"},
+		{[]string{"parse", "1.234,56 €", "--currency", "EUR"}, 0, "123456\n"},
+		{[]string{"parse", "--currency", "USD", "$1,234"}, 0, "123400\n"},
+		{[]string{"parse", "12,5", "--currency", "EUR"}, 0, "1250\n"},
+		{[]string{"parse", "1 234,56 zł", "--currency", "PLN"}, 0, "123456\n"},
+		{[]string{"parse", "-1.234", "--currency", "JPY"}, 0, "-1234\n"},
+		{[]string{"parse", "1.234.567", "--currency", "EUR"}, 0, "123456700\n"},
+		{[]string{"parse", "1'234.5", "--currency", "CHF", "--thousand", "'"}, 0, "123450\n"},
+		{[]string{"split", "1000", "GBP", "3"}, 0, "£3.34\n£3.33\n£3.33\n"},
+		{[]string{"split", "1000", "GBP", "3", "--minor"}, 0, "334\n333\n333\n"},
+		{[]string{"allocate", "1000", "USD", "1", "2", "3"}, 0, "$1.67\n$3.33\n$5.00\n"},
+		{[]string{"convert", "100", "USD", "EUR", "--rates", f.Name()}, 0, "€0.92\n"},
+		{[]string{"convert", "--minor", "--rates", f.Name(), "10000", "EUR", "USD"}, 0, "10870\n"},
+		{[]string{"help"}, 0, usage},
+		{[]string{"parse", "1,00"}, 2, ""},
+		{[]string{"split", "1000", "GBP"}, 2, ""},
+		{[]string{"format", "1000", "EUR", "--json"}, 2, ""},
+		{[]string{"round", "1000", "EUR"}, 2, ""},
+		{nil, 2, ""},
+		{[]string{"format", "12.5", "EUR"}, 1, ""},
+		{[]string{"format", "1000", "XXX"}, 1, ""},
+		{[]string{"parse", "1,2,3", "--currency", "EUR", "--decimal", ","}, 1, ""},
+		{[]string{"split", "1000", "GBP", "0"}, 1, ""},
+		{[]string{"allocate", "1000", "USD", "0", "0"}, 1, ""},
+		{[]string{"convert", "100", "USD", "JPY", "--rates", f.Name()}, 1, ""},
+		{[]string{"convert", "100", "USD", "EUR", "--rates", f.Name() + ".missing"}, 1, ""},
+	}
+
+	for _, tc := range tcs {
+		var stdout, stderr bytes.Buffer
+		code := run(tc.args, &stdout, &stderr)
+		if code != tc.code || stdout.String() != tc.expected {
+			t.Errorf("Expected %v to exit with %d and print %q got %d %q %q", tc.args, tc.code, tc.expected,
+				code, stdout.String(), stderr.String())
+		}
+
+		if code != 0 && stderr.Len() == 0 {
+			t.Errorf("Expected error message of %v", tc.args)
+		}
+	}
+}
+
+func TestRun_Currencies(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"currencies", "--json"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("Expected exit code 0 got %d %s", code, stderr.String())
+	}
+
+	var cs []amount.CurrencyJSON
+	if err := json.Unmarshal(stdout.Bytes(), &cs); err != nil {
+		t.Fatal(err)
+	}
+
+	var eur *amount.CurrencyJSON
+	for i := range cs {
+		if cs[i].Code == "EUR" {
+			eur = &cs[i]
+		}
+	}
+
+	expected := amount.CurrencyJSON{Code: "EUR", Fraction: 2, Grapheme: "€", Template: "$1", Decimal: ".", Thousand: ","}
+	if eur == nil || *eur != expected {
+		t.Errorf("Expected %+v got %+v", expected, eur)
+	}
+
+	stdout.Reset()
+	if code := run([]string{"currencies"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("Expected exit code 0 got %d %s", code, stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != len(cs)+1 || !strings.HasPrefix(lines[0], "Code") {
+		t.Errorf("Expected header and %d currencies got %d lines", len(cs), len(lines))
+	}
+}
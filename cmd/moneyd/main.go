@@ -0,0 +1,58 @@
+// Command moneyd serves money operations over HTTP for services which aren't written in Go.
+//
+// Requests and responses are JSON, amounts are in minor units of their currency.
+//
+//	POST /format     {"amount": 123456, "currency": "EUR"}
+//	POST /parse      {"text": "1.234,56 €", "currency": "EUR"}
+//	POST /split      {"amount": 1000, "currency": "GBP", "parties": 3}
+//	POST /allocate   {"amount": 1000, "currency": "USD", "ratios": [1, 2, 3]}
+//	POST /convert    {"amount": 100, "from": "USD", "to": "EUR", "rate": "0.92"}
+//	GET  /currencies
+//	GET  /currencies/EUR
+//
+// Amounts are returned as {"amount": 123456, "currency": "EUR", "display": "€1,234.56"}, split and
+// allocate return them in "parts". Conversions use the rate of the request, or rates of the CSV
+// file given with -rates. Errors are returned as {"error": "..."} with 4xx status.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/moneycsv"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	path := flag.String("rates", "", "CSV file with from, to and rate columns")
+	flag.Parse()
+
+	rates := money.NewRates()
+	if *path != "" {
+		f, err := os.Open(*path)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		rates, err = moneycsv.ReadRates(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", *path, err)
+		}
+	}
+
+	s := &http.Server{
+		Addr:         *addr,
+		Handler:      newServer(rates),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  time.Minute,
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(s.ListenAndServe())
+}
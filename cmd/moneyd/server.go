@@ -0,0 +1,363 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"mime"
+	"net/http"
+	"strings"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/amount"
+)
+
+const (
+	// maxBodySize is the largest accepted request body in bytes.
+	maxBodySize = 1 << 20
+	// maxParts is the highest number of parties or ratios of a request.
+	maxParts = 1000
+)
+
+// statusError is an error with HTTP status of the response.
+type statusError struct {
+	status int
+	err    error
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// invalid returns error of a request which fails validation.
+func invalid(format string, args ...interface{}) error {
+	return &statusError{status: http.StatusBadRequest, err: fmt.Errorf(format, args...)}
+}
+
+// moneyJSON is JSON representation of Money.
+type moneyJSON struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Display  string `json:"display"`
+}
+
+func newMoneyJSON(m *money.Money) moneyJSON {
+	return moneyJSON{Amount: m.Amount(), Currency: m.Currency().Code, Display: m.Display()}
+}
+
+// partsJSON is JSON representation of split or allocated Money.
+type partsJSON struct {
+	Parts []moneyJSON `json:"parts"`
+}
+
+func newPartsJSON(ms []*money.Money) partsJSON {
+	ps := partsJSON{Parts: make([]moneyJSON, len(ms))}
+	for i, m := range ms {
+		ps.Parts[i] = newMoneyJSON(m)
+	}
+
+	return ps
+}
+
+type formatRequest struct {
+	Amount   *int64 `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type parseRequest struct {
+	Text     string `json:"text"`
+	Currency string `json:"currency"`
+	// Decimal and Thousand separators are detected when empty.
+	Decimal  string `json:"decimal"`
+	Thousand string `json:"thousand"`
+}
+
+type splitRequest struct {
+	Amount   *int64 `json:"amount"`
+	Currency string `json:"currency"`
+	Parties  int    `json:"parties"`
+}
+
+type allocateRequest struct {
+	Amount   *int64 `json:"amount"`
+	Currency string `json:"currency"`
+	Ratios   []int  `json:"ratios"`
+}
+
+type convertRequest struct {
+	Amount *int64 `json:"amount"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	// Rate is the optional exchange rate, e.g. "0.92" or "1/3". Rates of the server are used when empty.
+	Rate string `json:"rate"`
+}
+
+// handlerFunc handles request and returns value of the JSON response.
+type handlerFunc func(r *http.Request) (interface{}, error)
+
+type server struct {
+	rates money.RateProvider
+}
+
+// newServer returns handler of all endpoints converting with given rates.
+func newServer(rates money.RateProvider) http.Handler {
+	s := &server{rates: rates}
+	mux := http.NewServeMux()
+	mux.Handle("/format", s.handle(http.MethodPost, s.format))
+	mux.Handle("/parse", s.handle(http.MethodPost, s.parse))
+	mux.Handle("/split", s.handle(http.MethodPost, s.split))
+	mux.Handle("/allocate", s.handle(http.MethodPost, s.allocate))
+	mux.Handle("/convert", s.handle(http.MethodPost, s.convert))
+	mux.Handle("/currencies", s.handle(http.MethodGet, s.currencies))
+	mux.Handle("/currencies/", s.handle(http.MethodGet, s.currency))
+	mux.Handle("/", s.handle("", s.notFound))
+
+	return mux
+}
+
+// handle returns handler allowing only given method and writing result of h as JSON.
+func (s *server) handle(method string, h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if method != "" && r.Method != method {
+			w.Header().Set("Allow", method)
+			writeJSON(w, http.StatusMethodNotAllowed, errorJSON(fmt.Errorf("method %s not allowed", r.Method)))
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+		v, err := h(r)
+		if err != nil {
+			writeJSON(w, status(err), errorJSON(err))
+			return
+		}
+
+		writeJSON(w, http.StatusOK, v)
+	})
+}
+
+// status returns HTTP status of the error. Errors of money operations are unprocessable.
+func status(err error) int {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.status
+	}
+
+	return http.StatusUnprocessableEntity
+}
+
+func errorJSON(err error) interface{} {
+	return struct {
+		Error string `json:"error"`
+	}{err.Error()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// decode decodes JSON body of the request into v, unknown fields are rejected.
+func decode(r *http.Request, v interface{}) error {
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mt, _, err := mime.ParseMediaType(ct); err != nil || mt != "application/json" {
+			return &statusError{status: http.StatusUnsupportedMediaType, err: fmt.Errorf("unsupported content type %q", ct)}
+		}
+	}
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(v)
+	// Bodies are limited by http.MaxBytesReader, its error has no type before Go 1.19.
+	if err != nil && strings.HasSuffix(err.Error(), "request body too large") {
+		return &statusError{status: http.StatusRequestEntityTooLarge, err: fmt.Errorf("request body exceeds %d bytes", maxBodySize)}
+	}
+
+	if err != nil {
+		return invalid("invalid JSON: %v", err)
+	}
+
+	if d.More() {
+		return invalid("invalid JSON: unexpected data after object")
+	}
+
+	return nil
+}
+
+// currency returns known currency with given code.
+func currency(field, code string) (*money.Currency, error) {
+	if code == "" {
+		return nil, invalid("%s is required", field)
+	}
+
+	c, err := amount.Currency(code)
+	if err != nil {
+		return nil, invalid("%s: %v", field, err)
+	}
+
+	return c, nil
+}
+
+// newMoney validates amount and currency of a request and returns them as Money.
+func newMoney(units *int64, code string) (*money.Money, error) {
+	if units == nil {
+		return nil, invalid("amount is required")
+	}
+
+	c, err := currency("currency", code)
+	if err != nil {
+		return nil, err
+	}
+
+	return money.New(*units, c.Code), nil
+}
+
+func (s *server) format(r *http.Request) (interface{}, error) {
+	var req formatRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+
+	m, err := newMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMoneyJSON(m), nil
+}
+
+func (s *server) parse(r *http.Request) (interface{}, error) {
+	var req parseRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, invalid("text is required")
+	}
+
+	c, err := currency("currency", req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := amount.Parse(req.Text, c, req.Decimal, req.Thousand)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMoneyJSON(money.New(a, c.Code)), nil
+}
+
+func (s *server) split(r *http.Request) (interface{}, error) {
+	var req splitRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+
+	m, err := newMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Parties <= 0 || req.Parties > maxParts {
+		return nil, invalid("parties must be between 1 and %d", maxParts)
+	}
+
+	ms, err := m.Split(req.Parties)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPartsJSON(ms), nil
+}
+
+func (s *server) allocate(r *http.Request) (interface{}, error) {
+	var req allocateRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+
+	m, err := newMoney(req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Ratios) == 0 || len(req.Ratios) > maxParts {
+		return nil, invalid("between 1 and %d ratios are required", maxParts)
+	}
+
+	ms, err := m.Allocate(req.Ratios...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPartsJSON(ms), nil
+}
+
+func (s *server) convert(r *http.Request) (interface{}, error) {
+	var req convertRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+
+	m, err := newMoney(req.Amount, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := currency("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	rp := s.rates
+	if req.Rate != "" {
+		rate, ok := new(big.Rat).SetString(req.Rate)
+		if !ok || rate.Sign() <= 0 {
+			return nil, invalid("invalid rate %q", req.Rate)
+		}
+
+		rates := money.NewRates()
+		rates.Set(m.Currency().Code, to.Code, rate)
+		rp = rates
+	}
+
+	c, err := m.Convert(to.Code, rp)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMoneyJSON(c), nil
+}
+
+func (s *server) currencies(r *http.Request) (interface{}, error) {
+	cs := money.Currencies()
+	js := make([]amount.CurrencyJSON, len(cs))
+	for i := range cs {
+		js[i] = amount.NewCurrencyJSON(&cs[i])
+	}
+
+	return struct {
+		Currencies []amount.CurrencyJSON `json:"currencies"`
+	}{js}, nil
+}
+
+func (s *server) currency(r *http.Request) (interface{}, error) {
+	code := strings.TrimPrefix(r.URL.Path, "/currencies/")
+	c, err := amount.Currency(code)
+	if err != nil {
+		return nil, &statusError{status: http.StatusNotFound, err: err}
+	}
+
+	return amount.NewCurrencyJSON(c), nil
+}
+
+func (s *server) notFound(r *http.Request) (interface{}, error) {
+	return nil, &statusError{status: http.StatusNotFound, err: fmt.Errorf("no endpoint %s", r.URL.Path)}
+}
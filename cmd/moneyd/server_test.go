@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/amount"
+)
+
+func newTestServer() *httptest.Server {
+	rates := money.NewRates()
+	rates.Set("USD", "EUR", big.NewRat(92, 100))
+
+	return httptest.NewServer(newServer(rates))
+}
+
+func TestServer(t *testing.T) {
+	ts := newTestServer()
+	defer ts.Close()
+
+	tcs := []struct {
+		path     string
+		body     string
+		expected string
+	}{
+		{"/format", `{"amount": 123456, "currency": "EUR"}`, `{"amount":123456,"currency":"EUR","display":"€1,234.56"}`},
+		{"/format", `{"amount": -5, "currency": "usd"}`, `{"amount":-5,"currency":"USD","display":"-$0.05"}`},
+		{"/parse", `{"text": "1.234,56 €", "currency": "EUR"}`, `{"amount":123456,"currency":"EUR","display":"€1,234.56"}`},
+		{"/parse", `{"text": "1'234.5", "currency": "CHF", "thousand": "'"}`, `{"amount":123450,"currency":"CHF","display":"1,234.50 CHF"}`},
+		{"/split", `{"amount": 1000, "currency": "GBP", "parties": 3}`,
+			`{"parts":[{"amount":334,"currency":"GBP","display":"£3.34"},{"amount":333,"currency":"GBP","display":"£3.33"},{"amount":333,"currency":"GBP","display":"£3.33"}]}`},
+		{"/allocate", `{"amount": 1000, "currency": "USD", "ratios": [1, 2, 3]}`,
+			`{"parts":[{"amount":167,"currency":"USD","display":"$1.67"},{"amount":333,"currency":"USD","display":"$3.33"},{"amount":500,"currency":"USD","display":"$5.00"}]}`},
+		{"/convert", `{"amount": 10000, "from": "USD", "to": "EUR"}`, `{"amount":9200,"currency":"EUR","display":"€92.00"}`},
+		{"/convert", `{"amount": 9200, "from": "EUR", "to": "USD"}`, `{"amount":10000,"currency":"USD","display":"$100.00"}`},
+		{"/convert", `{"amount": 300, "from": "EUR", "to": "GBP", "rate": "1/3"}`, `{"amount":100,"currency":"GBP","display":"£1.00"}`},
+	}
+
+	for _, tc := range tcs {
+		res, err := http.Post(ts.URL+tc.path, "application/json", strings.NewReader(tc.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if res.StatusCode != http.StatusOK || strings.TrimSpace(string(b)) != tc.expected {
+			t.Errorf("Expected %s %s to return %s got %d %s", tc.path, tc.body, tc.expected, res.StatusCode, b)
+		}
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	ts := newTestServer()
+	defer ts.Close()
+
+	tcs := []struct {
+		method      string
+		path        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{http.MethodGet, "/format", "", "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/currencies", "application/json", "{}", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/round", "application/json", "{}", http.StatusNotFound},
+		{http.MethodGet, "/currencies/XXX", "", "", http.StatusNotFound},
+		{http.MethodPost, "/format", "text/plain", `{"amount": 1, "currency": "EUR"}`, http.StatusUnsupportedMediaType},
+		{http.MethodPost, "/format", "application/json", `{"amount": 1, "currency": "EUR"`, http.StatusBadRequest},
+		{http.MethodPost, "/format", "application/json", `{"amount": 1, "currency": "EUR"} {}`, http.StatusBadRequest},
+		{http.MethodPost, "/format", "application/json", `{"amount": 1.5, "currency": "EUR"}`, http.StatusBadRequest},
+		{http.MethodPost, "/format", "application/json", `{"amount": 1, "currency": "EUR", "code": "EUR"}`, http.StatusBadRequest},
+		{http.MethodPost, "/format", "application/json", `{"currency": "EUR"}`, http.StatusBadRequest},
+		{http.MethodPost, "/format", "application/json", `{"amount": 1}`, http.StatusBadRequest},
+		{http.MethodPost, "/format", "application/json", `{"amount": 1, "currency": "XXX"}`, http.StatusBadRequest},
+		{http.MethodPost, "/parse", "application/json", `{"text": " ", "currency": "EUR"}`, http.StatusBadRequest},
+		{http.MethodPost, "/parse", "application/json", `{"text": "abc", "currency": "EUR"}`, http.StatusUnprocessableEntity},
+		{http.MethodPost, "/split", "application/json", `{"amount": 1, "currency": "EUR", "parties": 0}`, http.StatusBadRequest},
+		{http.MethodPost, "/split", "application/json", `{"amount": 1, "currency": "EUR", "parties": 1000000}`, http.StatusBadRequest},
+		{http.MethodPost, "/allocate", "application/json", `{"amount": 1, "currency": "EUR"}`, http.StatusBadRequest},
+		{http.MethodPost, "/allocate", "application/json", `{"amount": 1, "currency": "EUR", "ratios": [0, 0]}`, http.StatusUnprocessableEntity},
+		{http.MethodPost, "/allocate", "application/json", `{"amount": 1, "currency": "EUR", "ratios": [1, -1]}`, http.StatusUnprocessableEntity},
+		{http.MethodPost, "/convert", "application/json", `{"amount": 1, "from": "USD"}`, http.StatusBadRequest},
+		{http.MethodPost, "/convert", "application/json", `{"amount": 1, "from": "USD", "to": "EUR", "rate": "-1"}`, http.StatusBadRequest},
+		{http.MethodPost, "/convert", "application/json", `{"amount": 1, "from": "USD", "to": "JPY"}`, http.StatusUnprocessableEntity},
+		{http.MethodPost, "/parse", "application/json", `{"text": "` + strings.Repeat("1", maxBodySize) + `", "currency": "EUR"}`,
+			http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tc := range tcs {
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+		if tc.contentType != "" {
+			req.Header.Set("Content-Type", tc.contentType)
+		}
+
+		rec := httptest.NewRecorder()
+		newServer(money.NewRates()).ServeHTTP(rec, req)
+
+		var res struct {
+			Error string `json:"error"`
+		}
+
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		if rec.Code != tc.status || err != nil || res.Error == "" {
+			t.Errorf("Expected %s %s %.100s to fail with %d got %d %s", tc.method, tc.path, tc.body, tc.status, rec.Code,
+				rec.Body.String())
+		}
+	}
+}
+
+func TestServer_Currencies(t *testing.T) {
+	ts := newTestServer()
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/currencies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var list struct {
+		Currencies []amount.CurrencyJSON `json:"currencies"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&list); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Currencies) != len(money.Currencies()) {
+		t.Errorf("Expected %d currencies got %d", len(money.Currencies()), len(list.Currencies))
+	}
+
+	res, err = http.Get(ts.URL + "/currencies/aud")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var c amount.CurrencyJSON
+	if err := json.NewDecoder(res.Body).Decode(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := amount.CurrencyJSON{Code: "AUD", Fraction: 2, Grapheme: "$", Template: "$1", Decimal: ".", Thousand: ",", CashIncrement: 5}
+	if c != expected {
+		t.Errorf("Expected %+v got %+v", expected, c)
+	}
+}
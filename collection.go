@@ -0,0 +1,147 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Sum returns new Money struct with Value representing sum of all given Money.
+func Sum(ms ...*Money) (*Money, error) {
+	if err := assertCommonCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	total := bigSum(ms)
+	if !total.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return ms[0].withAmount(total.Int64()), nil
+}
+
+// Min returns new Money struct with the lowest Value of all given Money.
+func Min(ms ...*Money) (*Money, error) {
+	if err := assertCommonCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	min := ms[0]
+	for _, m := range ms[1:] {
+		if m.compare(min) < 0 {
+			min = m
+		}
+	}
+
+	return min.withAmount(min.Amount()), nil
+}
+
+// Max returns new Money struct with the highest Value of all given Money.
+func Max(ms ...*Money) (*Money, error) {
+	if err := assertCommonCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	max := ms[0]
+	for _, m := range ms[1:] {
+		if m.compare(max) > 0 {
+			max = m
+		}
+	}
+
+	return max.withAmount(max.Amount()), nil
+}
+
+// Average returns new Money struct with Value representing arithmetic mean of all given Money
+// rounded to minor units using given mode.
+func Average(mode RoundingMode, ms ...*Money) (*Money, error) {
+	if err := assertCommonCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	avg := mode.quo(bigSum(ms), big.NewInt(int64(len(ms))))
+
+	return ms[0].withAmount(avg.Int64()), nil
+}
+
+// Median returns new Money struct with the median Value of all given Money. When the number of
+// Money is even, mean of the two middle Values is rounded to minor units using given mode.
+func Median(mode RoundingMode, ms ...*Money) (*Money, error) {
+	if err := assertCommonCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	sorted := make([]*Money, len(ms))
+	copy(sorted, ms)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].compare(sorted[j]) < 0
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid].withAmount(sorted[mid].Amount()), nil
+	}
+
+	med := mode.quo(bigSum(sorted[mid-1:mid+1]), big.NewInt(2))
+
+	return ms[0].withAmount(med.Int64()), nil
+}
+
+// SortMoney sorts given Money by Value in ascending order. All Money must share one currency.
+func SortMoney(ms []*Money) error {
+	if len(ms) == 0 {
+		return nil
+	}
+
+	if err := assertCommonCurrency(ms); err != nil {
+		return err
+	}
+
+	sort.Stable(ByAmount(ms))
+
+	return nil
+}
+
+// ByAmount implements sort.Interface ordering Money by Value in ascending order.
+// Money in different currencies are ordered by currency code first.
+type ByAmount []*Money
+
+func (ms ByAmount) Len() int {
+	return len(ms)
+}
+
+func (ms ByAmount) Less(i, j int) bool {
+	if ci, cj := ms[i].currency().Code, ms[j].currency().Code; ci != cj {
+		return ci < cj
+	}
+
+	return ms[i].compare(ms[j]) < 0
+}
+
+func (ms ByAmount) Swap(i, j int) {
+	ms[i], ms[j] = ms[j], ms[i]
+}
+
+// assertCommonCurrency checks that at least one Money is given and all of them share one currency.
+func assertCommonCurrency(ms []*Money) error {
+	if len(ms) == 0 {
+		return ErrEmpty
+	}
+
+	for _, m := range ms[1:] {
+		if err := ms[0].assertSameCurrencyData(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bigSum returns sum of given Money Values without overflowing.
+func bigSum(ms []*Money) *big.Int {
+	total := new(big.Int)
+	for _, m := range ms {
+		total.Add(total, big.NewInt(m.Amount()))
+	}
+
+	return total
+}
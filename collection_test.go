@@ -0,0 +1,179 @@
+package money
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		expected int64
+	}{
+		{[]int64{100}, 100},
+		{[]int64{100, 200, -50}, 250},
+		{[]int64{math.MaxInt64, 1, -1}, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		var ms []*Money
+		for _, a := range tc.amounts {
+			ms = append(ms, New(a, "EUR"))
+		}
+
+		r, err := Sum(ms...)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r.Amount() != tc.expected || r.Currency().Code != "EUR" {
+			t.Errorf("Expected sum of %v to be %d got %d", tc.amounts, tc.expected, r.Amount())
+		}
+	}
+}
+
+func TestSum2(t *testing.T) {
+	if r, err := Sum(); r != nil || err != ErrEmpty {
+		t.Errorf("Expected %v got %v", ErrEmpty, err)
+	}
+
+	if r, err := Sum(New(math.MaxInt64, "EUR"), New(1, "EUR")); r != nil || err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if r, err := Sum(New(1, "EUR"), New(1, "USD")); r != nil || err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	ms := []*Money{New(300, "EUR"), New(-100, "EUR"), New(200, "EUR")}
+
+	min, err := Min(ms...)
+	if err != nil || min.Amount() != -100 {
+		t.Errorf("Expected min %d got %v, %v", -100, min, err)
+	}
+
+	max, err := Max(ms...)
+	if err != nil || max.Amount() != 300 {
+		t.Errorf("Expected max %d got %v, %v", 300, max, err)
+	}
+
+	if _, err := Min(); err != ErrEmpty {
+		t.Errorf("Expected %v got %v", ErrEmpty, err)
+	}
+
+	if _, err := Max(New(1, "EUR"), New(1, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{[]int64{100, 200}, RoundHalfUp, 150},
+		{[]int64{100, 101}, RoundHalfUp, 101},
+		{[]int64{100, 101}, RoundHalfEven, 100},
+		{[]int64{100, 100, 101}, RoundUp, 101},
+		{[]int64{math.MaxInt64, math.MaxInt64}, RoundHalfUp, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		var ms []*Money
+		for _, a := range tc.amounts {
+			ms = append(ms, New(a, "EUR"))
+		}
+
+		r, err := Average(tc.mode, ms...)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r.Amount() != tc.expected {
+			t.Errorf("Expected average of %v to be %d got %d", tc.amounts, tc.expected, r.Amount())
+		}
+	}
+
+	if _, err := Average(RoundHalfUp); err != ErrEmpty {
+		t.Errorf("Expected %v got %v", ErrEmpty, err)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		expected int64
+	}{
+		{[]int64{300, 100, 200}, 200},
+		{[]int64{400, 100, 200, 301}, 251},
+		{[]int64{5}, 5},
+	}
+
+	for _, tc := range tcs {
+		var ms []*Money
+		for _, a := range tc.amounts {
+			ms = append(ms, New(a, "EUR"))
+		}
+
+		r, err := Median(RoundHalfUp, ms...)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r.Amount() != tc.expected {
+			t.Errorf("Expected median of %v to be %d got %d", tc.amounts, tc.expected, r.Amount())
+		}
+
+		if ms[0].Amount() != tc.amounts[0] {
+			t.Errorf("Expected median not to reorder input")
+		}
+	}
+
+	if _, err := Median(RoundHalfUp); err != ErrEmpty {
+		t.Errorf("Expected %v got %v", ErrEmpty, err)
+	}
+}
+
+func TestSortMoney(t *testing.T) {
+	ms := []*Money{New(300, "EUR"), New(-100, "EUR"), New(200, "EUR")}
+
+	if err := SortMoney(ms); err != nil {
+		t.Fatal(err)
+	}
+
+	var rs []int64
+	for _, m := range ms {
+		rs = append(rs, m.Amount())
+	}
+
+	if !reflect.DeepEqual([]int64{-100, 200, 300}, rs) {
+		t.Errorf("Expected sorted %v got %v", []int64{-100, 200, 300}, rs)
+	}
+
+	if err := SortMoney([]*Money{New(1, "EUR"), New(1, "USD")}); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestByAmount(t *testing.T) {
+	ms := []*Money{New(300, "USD"), New(-100, "EUR"), New(200, "USD"), New(100, "EUR")}
+	sort.Sort(ByAmount(ms))
+
+	var rs []string
+	for _, m := range ms {
+		rs = append(rs, m.Display())
+	}
+
+	expected := []string{"-€1.00", "€1.00", "$2.00", "$3.00"}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Expected sorted %v got %v", expected, rs)
+	}
+}
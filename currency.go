@@ -1,6 +1,7 @@
 package money
 
 import (
+	"sort"
 	"strings"
 )
 
@@ -12,6 +13,9 @@ type Currency struct {
 	Template string
 	Decimal  string
 	Thousand string
+	// CashIncrement is the smallest amount, in minor units, that can be paid
+	// in cash. Zero means cash payments are not rounded beyond the minor unit.
+	CashIncrement int64
 }
 
 // currencies represents a collection of currency.
@@ -23,7 +27,7 @@ var currencies = map[string]*Currency{
 	"ANG": {Decimal: ",", Thousand: ".", Code: "ANG", Fraction: 2, Grapheme: "\u0192", Template: "$1"},
 	"AOA": {Decimal: ".", Thousand: ",", Code: "AOA", Fraction: 2, Grapheme: "Kz", Template: "1$"},
 	"ARS": {Decimal: ".", Thousand: ",", Code: "ARS", Fraction: 2, Grapheme: "$", Template: "$1"},
-	"AUD": {Decimal: ".", Thousand: ",", Code: "AUD", Fraction: 2, Grapheme: "$", Template: "$1"},
+	"AUD": {Decimal: ".", Thousand: ",", Code: "AUD", Fraction: 2, Grapheme: "$", Template: "$1", CashIncrement: 5},
 	"AWG": {Decimal: ".", Thousand: ",", Code: "AWG", Fraction: 2, Grapheme: "\u0192", Template: "1$"},
 	"AZN": {Decimal: ".", Thousand: ",", Code: "AZN", Fraction: 2, Grapheme: "\u20bc", Template: "$1"},
 	"BAM": {Decimal: ".", Thousand: ",", Code: "BAM", Fraction: 2, Grapheme: "KM", Template: "$1"},
@@ -42,9 +46,9 @@ var currencies = map[string]*Currency{
 	"BYN": {Decimal: ",", Thousand: " ", Code: "BYN", Fraction: 2, Grapheme: "p.", Template: "1 $"},
 	"BYR": {Decimal: ",", Thousand: " ", Code: "BYR", Fraction: 0, Grapheme: "p.", Template: "1 $"},
 	"BZD": {Decimal: ".", Thousand: ",", Code: "BZD", Fraction: 2, Grapheme: "BZ$", Template: "$1"},
-	"CAD": {Decimal: ".", Thousand: ",", Code: "CAD", Fraction: 2, Grapheme: "$", Template: "$1"},
+	"CAD": {Decimal: ".", Thousand: ",", Code: "CAD", Fraction: 2, Grapheme: "$", Template: "$1", CashIncrement: 5},
 	"CDF": {Decimal: ".", Thousand: ",", Code: "CDF", Fraction: 2, Grapheme: "FC", Template: "1$"},
-	"CHF": {Decimal: ".", Thousand: ",", Code: "CHF", Fraction: 2, Grapheme: "CHF", Template: "1 $"},
+	"CHF": {Decimal: ".", Thousand: ",", Code: "CHF", Fraction: 2, Grapheme: "CHF", Template: "1 $", CashIncrement: 5},
 	"CLF": {Decimal: ",", Thousand: ".", Code: "CLF", Fraction: 4, Grapheme: "UF", Template: "$1"},
 	"CLP": {Decimal: ",", Thousand: ".", Code: "CLP", Fraction: 0, Grapheme: "$", Template: "$1"},
 	"CNY": {Decimal: ".", Thousand: ",", Code: "CNY", Fraction: 2, Grapheme: "\u5143", Template: "1 $"},
@@ -53,9 +57,9 @@ var currencies = map[string]*Currency{
 	"CUC": {Decimal: ".", Thousand: ",", Code: "CUC", Fraction: 2, Grapheme: "$", Template: "1$"},
 	"CUP": {Decimal: ".", Thousand: ",", Code: "CUP", Fraction: 2, Grapheme: "$MN", Template: "$1"},
 	"CVE": {Decimal: ".", Thousand: ",", Code: "CVE", Fraction: 2, Grapheme: "$", Template: "1$"},
-	"CZK": {Decimal: ".", Thousand: ",", Code: "CZK", Fraction: 2, Grapheme: "K\u010d", Template: "1 $"},
+	"CZK": {Decimal: ".", Thousand: ",", Code: "CZK", Fraction: 2, Grapheme: "K\u010d", Template: "1 $", CashIncrement: 100},
 	"DJF": {Decimal: ".", Thousand: ",", Code: "DJF", Fraction: 0, Grapheme: "Fdj", Template: "1 $"},
-	"DKK": {Decimal: ",", Thousand: ".", Code: "DKK", Fraction: 2, Grapheme: "kr", Template: "$ 1"},
+	"DKK": {Decimal: ",", Thousand: ".", Code: "DKK", Fraction: 2, Grapheme: "kr", Template: "$ 1", CashIncrement: 50},
 	"DOP": {Decimal: ".", Thousand: ",", Code: "DOP", Fraction: 2, Grapheme: "RD$", Template: "$1"},
 	"DZD": {Decimal: ".", Thousand: ",", Code: "DZD", Fraction: 2, Grapheme: ".\u062f.\u062c", Template: "1 $"},
 	"EEK": {Decimal: ".", Thousand: ",", Code: "EEK", Fraction: 2, Grapheme: "kr", Template: "$1"},
@@ -79,7 +83,7 @@ var currencies = map[string]*Currency{
 	"HNL": {Decimal: ".", Thousand: ",", Code: "HNL", Fraction: 2, Grapheme: "L", Template: "$1"},
 	"HRK": {Decimal: ",", Thousand: ".", Code: "HRK", Fraction: 2, Grapheme: "kn", Template: "1 $"},
 	"HTG": {Decimal: ",", Thousand: ".", Code: "HTG", Fraction: 2, Grapheme: "G", Template: "1 $"},
-	"HUF": {Decimal: ".", Thousand: ",", Code: "HUF", Fraction: 0, Grapheme: "Ft", Template: "$1"},
+	"HUF": {Decimal: ".", Thousand: ",", Code: "HUF", Fraction: 0, Grapheme: "Ft", Template: "$1", CashIncrement: 5},
 	"IDR": {Decimal: ".", Thousand: ",", Code: "IDR", Fraction: 2, Grapheme: "Rp", Template: "$1"},
 	"ILS": {Decimal: ".", Thousand: ",", Code: "ILS", Fraction: 2, Grapheme: "\u20aa", Template: "$1"},
 	"IMP": {Decimal: ".", Thousand: ",", Code: "IMP", Fraction: 2, Grapheme: "\u00a3", Template: "$1"},
@@ -123,9 +127,9 @@ var currencies = map[string]*Currency{
 	"NAD": {Decimal: ".", Thousand: ",", Code: "NAD", Fraction: 2, Grapheme: "$", Template: "$1"},
 	"NGN": {Decimal: ".", Thousand: ",", Code: "NGN", Fraction: 2, Grapheme: "\u20a6", Template: "$1"},
 	"NIO": {Decimal: ".", Thousand: ",", Code: "NIO", Fraction: 2, Grapheme: "C$", Template: "$1"},
-	"NOK": {Decimal: ".", Thousand: ",", Code: "NOK", Fraction: 2, Grapheme: "kr", Template: "1 $"},
+	"NOK": {Decimal: ".", Thousand: ",", Code: "NOK", Fraction: 2, Grapheme: "kr", Template: "1 $", CashIncrement: 100},
 	"NPR": {Decimal: ".", Thousand: ",", Code: "NPR", Fraction: 2, Grapheme: "\u20a8", Template: "$1"},
-	"NZD": {Decimal: ".", Thousand: ",", Code: "NZD", Fraction: 2, Grapheme: "$", Template: "$1"},
+	"NZD": {Decimal: ".", Thousand: ",", Code: "NZD", Fraction: 2, Grapheme: "$", Template: "$1", CashIncrement: 10},
 	"OMR": {Decimal: ".", Thousand: ",", Code: "OMR", Fraction: 3, Grapheme: "\ufdfc", Template: "1 $"},
 	"PAB": {Decimal: ".", Thousand: ",", Code: "PAB", Fraction: 2, Grapheme: "B/.", Template: "$1"},
 	"PEN": {Decimal: ".", Thousand: ",", Code: "PEN", Fraction: 2, Grapheme: "S/", Template: "$1"},
@@ -144,7 +148,7 @@ var currencies = map[string]*Currency{
 	"SBD": {Decimal: ".", Thousand: ",", Code: "SBD", Fraction: 2, Grapheme: "$", Template: "$1"},
 	"SCR": {Decimal: ".", Thousand: ",", Code: "SCR", Fraction: 2, Grapheme: "\u20a8", Template: "$1"},
 	"SDG": {Decimal: ".", Thousand: ",", Code: "SDG", Fraction: 2, Grapheme: "\u00a3", Template: "$1"},
-	"SEK": {Decimal: ".", Thousand: ",", Code: "SEK", Fraction: 2, Grapheme: "kr", Template: "1 $"},
+	"SEK": {Decimal: ".", Thousand: ",", Code: "SEK", Fraction: 2, Grapheme: "kr", Template: "1 $", CashIncrement: 100},
 	"SGD": {Decimal: ".", Thousand: ",", Code: "SGD", Fraction: 2, Grapheme: "$", Template: "$1"},
 	"SHP": {Decimal: ".", Thousand: ",", Code: "SHP", Fraction: 2, Grapheme: "\u00a3", Template: "$1"},
 	"SKK": {Decimal: ".", Thousand: ",", Code: "SKK", Fraction: 2, Grapheme: "Sk", Template: "$1"},
@@ -204,9 +208,30 @@ func newCurrency(code string) *Currency {
 	return &Currency{Code: strings.ToUpper(code)}
 }
 
-// GetCurrency returns the currency given the code.
+// GetCurrency returns a copy of the currency given the code or nil when it is unknown.
+// Use AddCurrency to change it.
 func GetCurrency(code string) *Currency {
-	return currencies[code]
+	c, ok := currencies[code]
+	if !ok {
+		return nil
+	}
+
+	cc := *c
+	return &cc
+}
+
+// Currencies returns copies of all known currencies ordered by code. Use AddCurrency to change them.
+func Currencies() []Currency {
+	cs := make([]Currency, 0, len(currencies))
+	for _, c := range currencies {
+		cs = append(cs, *c)
+	}
+
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].Code < cs[j].Code
+	})
+
+	return cs
 }
 
 // Formatter returns currency formatter representing
@@ -236,6 +261,23 @@ func (c *Currency) get() *Currency {
 	return c.getDefault()
 }
 
+// SetCashIncrement sets the smallest amount, in minor units, that can be paid in cash, e.g. 5 for
+// 0.05 steps. Values below 1 disable cash rounding. It returns the currency so it can be chained
+// with AddCurrency.
+func (c *Currency) SetCashIncrement(increment int64) *Currency {
+	c.CashIncrement = increment
+	return c
+}
+
+// cashIncrement returns increment used for cash rounding.
+func (c *Currency) cashIncrement() int64 {
+	if c.CashIncrement <= 0 {
+		return 1
+	}
+
+	return c.CashIncrement
+}
+
 func (c *Currency) equals(oc *Currency) bool {
 	return c.Code == oc.Code
 }
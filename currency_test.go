@@ -86,3 +86,33 @@ func TestCurrency_GetNonExistingCurrency(t *testing.T) {
 		t.Errorf("Unexpected currency returned %+v", currency)
 	}
 }
+
+func TestCurrencies(t *testing.T) {
+	cs := Currencies()
+	if len(cs) != len(currencies) {
+		t.Errorf("Expected %d currencies got %d", len(currencies), len(cs))
+	}
+
+	for i := 1; i < len(cs); i++ {
+		if cs[i-1].Code >= cs[i].Code {
+			t.Errorf("Expected %s before %s", cs[i].Code, cs[i-1].Code)
+		}
+	}
+
+	if GetCurrency("USD") == nil {
+		t.Errorf("Expected USD in currencies")
+	}
+
+	for i := range cs {
+		cs[i].Grapheme = "?"
+	}
+
+	if c := GetCurrency("USD"); c.Grapheme != "$" {
+		t.Errorf("Expected registered USD to keep grapheme %s got %s", "$", c.Grapheme)
+	}
+
+	GetCurrency("USD").Grapheme = "?"
+	if c := GetCurrency("USD"); c.Grapheme != "$" {
+		t.Errorf("Expected registered USD to keep grapheme %s got %s", "$", c.Grapheme)
+	}
+}
@@ -0,0 +1,300 @@
+// Package discount applies promotions to cart lines on top of money.Money.
+//
+// Rules are applied in order, each one on the totals left by the previous rules, so
+// discounts stack. Order level discounts are spread back onto the lines proportionally
+// to their totals without losing pennies.
+package discount
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/percent"
+)
+
+var (
+	// ErrNoLines is returned when there are no cart lines.
+	ErrNoLines = errors.New("no lines given")
+	// ErrInvalidLine is returned when a line has no price or non positive quantity.
+	ErrInvalidLine = errors.New("invalid line")
+	// ErrInvalidRule is returned when a rule is misconfigured.
+	ErrInvalidRule = errors.New("invalid discount rule")
+)
+
+// Line is a cart line.
+type Line struct {
+	SKU       string
+	UnitPrice *money.Money
+	Quantity  int64
+}
+
+// Rule calculates discounts of cart lines.
+type Rule interface {
+	// Name identifies the rule in the breakdown.
+	Name() string
+	// Discounts returns discount of every line given the line totals left by the preceding rules.
+	// Discounts must not be negative nor exceed the line totals.
+	Discounts(lines []Line, totals []*money.Money) ([]*money.Money, error)
+}
+
+// Applied is breakdown of a single applied rule.
+type Applied struct {
+	Rule  string
+	Total *money.Money
+	Lines []*money.Money
+}
+
+// LineResult holds discounted line.
+type LineResult struct {
+	Line     Line
+	Original *money.Money
+	Discount *money.Money
+	Total    *money.Money
+}
+
+// Result holds discounted cart.
+type Result struct {
+	Original *money.Money
+	Discount *money.Money
+	Total    *money.Money
+	Lines    []LineResult
+	Applied  []Applied
+}
+
+// Apply applies given rules in order to cart lines.
+func Apply(lines []Line, rules ...Rule) (*Result, error) {
+	if len(lines) == 0 {
+		return nil, ErrNoLines
+	}
+
+	totals := make([]*money.Money, len(lines))
+	for i, l := range lines {
+		if !l.UnitPrice.Valid() || l.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: line %d", ErrInvalidLine, i)
+		}
+
+		if err := sameCurrency(lines[0].UnitPrice, l.UnitPrice); err != nil {
+			return nil, err
+		}
+
+		totals[i] = l.UnitPrice.Multiply(l.Quantity)
+	}
+
+	res := &Result{}
+	original := totals
+	for _, r := range rules {
+		ds, err := r.Discounts(lines, totals)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ds) != len(lines) {
+			return nil, fmt.Errorf("%w: %s returned %d discounts for %d lines", ErrInvalidRule, r.Name(), len(ds), len(lines))
+		}
+
+		next := make([]*money.Money, len(lines))
+		for i, d := range ds {
+			if d.IsNegative() {
+				return nil, fmt.Errorf("%w: %s returned negative discount", ErrInvalidRule, r.Name())
+			}
+
+			if next[i], err = totals[i].Subtract(d); err != nil {
+				return nil, err
+			}
+
+			if next[i].IsNegative() {
+				return nil, fmt.Errorf("%w: %s discount exceeds line %d", ErrInvalidRule, r.Name(), i)
+			}
+		}
+
+		total, err := money.Sum(ds...)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Applied = append(res.Applied, Applied{Rule: r.Name(), Total: total, Lines: ds})
+		totals = next
+	}
+
+	for i, l := range lines {
+		d, _ := original[i].Subtract(totals[i])
+		res.Lines = append(res.Lines, LineResult{Line: l, Original: original[i], Discount: d, Total: totals[i]})
+	}
+
+	res.Original, _ = money.Sum(original...)
+	res.Total, _ = money.Sum(totals...)
+	res.Discount, _ = res.Original.Subtract(res.Total)
+
+	return res, nil
+}
+
+type percentRule struct {
+	name    string
+	percent string
+	mode    money.RoundingMode
+	skus    []string
+}
+
+// Percent returns rule discounting given percentage (e.g. "10" or "12.5%") of lines with given SKUs,
+// or of the whole order when no SKU is given. The discount is rounded once using given mode
+// and spread over the lines proportionally to their totals.
+func Percent(name, percent string, mode money.RoundingMode, skus ...string) Rule {
+	return &percentRule{name: name, percent: percent, mode: mode, skus: skus}
+}
+
+func (r *percentRule) Name() string {
+	return r.name
+}
+
+func (r *percentRule) Discounts(lines []Line, totals []*money.Money) ([]*money.Money, error) {
+	p, ok := percent.Parse(r.percent)
+	if !ok || p.Sign() < 0 || p.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, fmt.Errorf("%w: %s has invalid percentage %q", ErrInvalidRule, r.name, r.percent)
+	}
+
+	eligible := eligibleTotals(lines, totals, r.skus)
+	sum, err := money.Sum(eligible...)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := sum.MultiplyRat(p.Quo(p, big.NewRat(100, 1)), r.mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(d, eligible)
+}
+
+type fixedRule struct {
+	name   string
+	amount *money.Money
+	skus   []string
+}
+
+// Fixed returns rule discounting given amount from lines with given SKUs, or from the whole order
+// when no SKU is given. The discount is limited to the total of the lines and spread over the lines
+// proportionally to their totals.
+func Fixed(name string, amount *money.Money, skus ...string) Rule {
+	return &fixedRule{name: name, amount: amount, skus: skus}
+}
+
+func (r *fixedRule) Name() string {
+	return r.name
+}
+
+func (r *fixedRule) Discounts(lines []Line, totals []*money.Money) ([]*money.Money, error) {
+	if r.amount.IsNegative() {
+		return nil, fmt.Errorf("%w: %s has negative amount", ErrInvalidRule, r.name)
+	}
+
+	eligible := eligibleTotals(lines, totals, r.skus)
+	sum, err := money.Sum(eligible...)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := r.amount.MinOf(sum)
+	if err != nil {
+		return nil, err
+	}
+
+	return spread(d, eligible)
+}
+
+type buyXGetYRule struct {
+	name string
+	sku  string
+	x, y int64
+}
+
+// BuyXGetY returns rule giving y units of given SKU for free for every x units bought.
+func BuyXGetY(name, sku string, x, y int64) Rule {
+	return &buyXGetYRule{name: name, sku: sku, x: x, y: y}
+}
+
+func (r *buyXGetYRule) Name() string {
+	return r.name
+}
+
+func (r *buyXGetYRule) Discounts(lines []Line, totals []*money.Money) ([]*money.Money, error) {
+	if r.x <= 0 || r.y <= 0 {
+		return nil, fmt.Errorf("%w: %s must buy and get at least one unit", ErrInvalidRule, r.name)
+	}
+
+	ds := make([]*money.Money, len(lines))
+	for i, l := range lines {
+		ds[i] = money.New(0, l.UnitPrice.Currency().Code)
+		if l.SKU != r.sku {
+			continue
+		}
+
+		free := l.Quantity / (r.x + r.y) * r.y
+		d, err := l.UnitPrice.Multiply(free).MinOf(totals[i])
+		if err != nil {
+			return nil, err
+		}
+
+		ds[i], _ = d.MaxOf(ds[i])
+	}
+
+	return ds, nil
+}
+
+// eligibleTotals returns totals of lines with given SKUs, zero for other lines.
+// All lines are eligible when no SKU is given.
+func eligibleTotals(lines []Line, totals []*money.Money, skus []string) []*money.Money {
+	es := make([]*money.Money, len(lines))
+	for i, l := range lines {
+		es[i] = totals[i]
+		if len(skus) > 0 && !contains(skus, l.SKU) {
+			es[i] = money.New(0, totals[i].Currency().Code)
+		}
+	}
+
+	return es
+}
+
+// spread allocates discount over lines proportionally to their totals.
+func spread(d *money.Money, totals []*money.Money) ([]*money.Money, error) {
+	ws := make([]*big.Rat, len(totals))
+	var sum int64
+	for i, t := range totals {
+		ws[i] = new(big.Rat)
+		if t.IsPositive() {
+			ws[i].SetInt64(t.Amount())
+			sum += t.Amount()
+		}
+	}
+
+	if sum == 0 {
+		ds := make([]*money.Money, len(totals))
+		for i, t := range totals {
+			ds[i] = money.New(0, t.Currency().Code)
+		}
+
+		return ds, nil
+	}
+
+	return d.AllocateWeightsWith(money.RemainderLargest, ws)
+}
+
+func sameCurrency(m, om *money.Money) error {
+	if !m.SameCurrencyData(om) {
+		return &money.CurrencyMismatchError{Left: m.Currency().Code, Right: om.Currency().Code}
+	}
+
+	return nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+package discount
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestApply(t *testing.T) {
+	lines := []Line{
+		{SKU: "shirt", UnitPrice: money.New(1999, "EUR"), Quantity: 2},
+		{SKU: "socks", UnitPrice: money.New(499, "EUR"), Quantity: 5},
+		{SKU: "hat", UnitPrice: money.New(1000, "EUR"), Quantity: 1},
+	}
+
+	tcs := []struct {
+		rule     Rule
+		discount int64
+		expected []int64
+	}{
+		// 10% of €74.93 is €7.49 spread over €39.98, €24.95 and €10.00.
+		{Percent("summer sale", "10", money.RoundHalfUp), 749, []int64{400, 249, 100}},
+		{Percent("socks", "50", money.RoundHalfUp, "socks"), 1248, []int64{0, 1248, 0}},
+		{Fixed("voucher", money.New(1000, "EUR")), 1000, []int64{534, 333, 133}},
+		{Fixed("hat voucher", money.New(5000, "EUR"), "hat"), 1000, []int64{0, 0, 1000}},
+		{BuyXGetY("socks 2+1", "socks", 2, 1), 499, []int64{0, 499, 0}},
+	}
+
+	for _, tc := range tcs {
+		res, err := Apply(lines, tc.rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var rs []int64
+		for _, l := range res.Lines {
+			rs = append(rs, l.Discount.Amount())
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected %s line discounts %v got %v", tc.rule.Name(), tc.expected, rs)
+		}
+
+		if res.Original.Amount() != 7493 || res.Discount.Amount() != tc.discount || res.Total.Amount() != 7493-tc.discount {
+			t.Errorf("Expected %s 7493 - %d = %d got %d - %d = %d", tc.rule.Name(), tc.discount, 7493-tc.discount,
+				res.Original.Amount(), res.Discount.Amount(), res.Total.Amount())
+		}
+	}
+}
+
+func TestApply_Stacked(t *testing.T) {
+	lines := []Line{
+		{SKU: "shirt", UnitPrice: money.New(1999, "EUR"), Quantity: 2},
+		{SKU: "socks", UnitPrice: money.New(499, "EUR"), Quantity: 5},
+		{SKU: "hat", UnitPrice: money.New(1000, "EUR"), Quantity: 1},
+	}
+
+	res, err := Apply(lines,
+		BuyXGetY("socks 2+1", "socks", 2, 1),
+		Percent("summer sale", "10", money.RoundHalfUp),
+		Fixed("voucher", money.New(500, "EUR")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Applied) != 3 {
+		t.Fatalf("Expected 3 applied rules got %d", len(res.Applied))
+	}
+
+	// Sale takes 10% of €69.94 left after the free pair of socks.
+	if res.Applied[1].Total.Amount() != 699 {
+		t.Errorf("Expected sale discount %d got %d", 699, res.Applied[1].Total.Amount())
+	}
+
+	var total int64
+	for _, a := range res.Applied {
+		sum, _ := money.Sum(a.Lines...)
+		if sum.Amount() != a.Total.Amount() {
+			t.Errorf("Expected %s line discounts to sum to %d got %d", a.Rule, a.Total.Amount(), sum.Amount())
+		}
+
+		total += a.Total.Amount()
+	}
+
+	if total != res.Discount.Amount() || res.Original.Amount()-res.Discount.Amount() != res.Total.Amount() {
+		t.Errorf("Expected discounts to sum to %d got %d", res.Discount.Amount(), total)
+	}
+
+	for _, l := range res.Lines {
+		if l.Original.Amount()-l.Discount.Amount() != l.Total.Amount() {
+			t.Errorf("Expected line %s %d - %d = %d", l.Line.SKU, l.Original.Amount(), l.Discount.Amount(),
+				l.Total.Amount())
+		}
+	}
+}
+
+func TestApply2(t *testing.T) {
+	lines := []Line{{SKU: "socks", UnitPrice: money.New(499, "EUR"), Quantity: 5}}
+
+	tcs := []struct {
+		lines    []Line
+		rule     Rule
+		expected error
+	}{
+		{nil, nil, ErrNoLines},
+		{[]Line{{SKU: "x", UnitPrice: money.New(100, "EUR")}}, nil, ErrInvalidLine},
+		{[]Line{{SKU: "x", Quantity: 1}}, nil, ErrInvalidLine},
+		{[]Line{{SKU: "x", UnitPrice: money.New(100, "EUR"), Quantity: 1}, {SKU: "y", UnitPrice: money.New(100, "USD"), Quantity: 1}},
+			nil, money.ErrCurrencyMismatch},
+		{lines, Percent("x", "110", money.RoundHalfUp), ErrInvalidRule},
+		{lines, Percent("x", "ten", money.RoundHalfUp), ErrInvalidRule},
+		{lines, Fixed("x", money.New(-100, "EUR")), ErrInvalidRule},
+		{lines, Fixed("x", money.New(100, "USD")), money.ErrCurrencyMismatch},
+		{lines, BuyXGetY("x", "socks", 0, 1), ErrInvalidRule},
+	}
+
+	for i, tc := range tcs {
+		var rules []Rule
+		if tc.rule != nil {
+			rules = append(rules, tc.rule)
+		}
+
+		res, err := Apply(tc.lines, rules...)
+		if res != nil || !errors.Is(err, tc.expected) {
+			t.Errorf("%d: expected %v got %v", i, tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by the package. Returned errors may wrap them with details,
+// compare using errors.Is.
+var (
+	// ErrCurrencyMismatch is returned when operation requires Money of the same currency.
+	// Use errors.As with *CurrencyMismatchError to get the currencies.
+	ErrCurrencyMismatch = errors.New("currencies don't match")
+	// ErrInvalidSplit is returned when Money is split into zero or less parties.
+	ErrInvalidSplit = errors.New("split must be higher than zero")
+	// ErrNoRatios is returned when allocation gets no ratios, weights, percentages or amounts.
+	ErrNoRatios = errors.New("no ratios specified")
+	// ErrInvalidRatio is returned when allocation gets nil or negative ratio or weight.
+	ErrInvalidRatio = errors.New("invalid ratio")
+	// ErrZeroRatios is returned when ratios of allocation sum to zero.
+	ErrZeroRatios = errors.New("sum of ratios must be higher than zero")
+	// ErrInvalidPercentage is returned when percentage can't be parsed or is out of range.
+	ErrInvalidPercentage = errors.New("invalid percentage")
+	// ErrInvalidRemainder is returned when RemainderStrategy doesn't distribute exactly the leftover pennies.
+	ErrInvalidRemainder = errors.New("remainder strategy must distribute exactly the leftover pennies")
+	// ErrInvalidRule is returned when allocation rules can't be evaluated.
+	// Use errors.As with *RuleError to get the failing rule.
+	ErrInvalidRule = errors.New("invalid allocation rule")
+	// ErrNegativeAmount is returned when operation requires Money which is not negative.
+	ErrNegativeAmount = errors.New("amount must not be negative")
+	// ErrInvalidRange is returned when lower bound of a range is higher than the upper bound.
+	ErrInvalidRange = errors.New("invalid range")
+	// ErrDivisionByZero is returned when dividing by zero Value.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrEmpty is returned by collection functions when no Money is given.
+	ErrEmpty = errors.New("no money given")
+	// ErrOverflow is returned when a result doesn't fit into the int64 Value.
+	ErrOverflow = errors.New("amount overflows")
+	// ErrNoRate is returned by RateProvider when exchange rate is unknown.
+	ErrNoRate = errors.New("no exchange rate")
+	// ErrInvalidRate is returned when exchange rate isn't positive.
+	ErrInvalidRate = errors.New("invalid exchange rate")
+	// ErrInvalidJSON is returned when Money can't be decoded from JSON.
+	ErrInvalidJSON = errors.New("invalid money JSON")
+	// ErrInvalidAmount is returned when an amount can't be parsed.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrInvalidSchedule is returned when due dates of installments can't be determined.
+	ErrInvalidSchedule = errors.New("invalid installment schedule")
+	// ErrUnsupportedLanguage is returned when amounts can't be spelled out in a language.
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+	// ErrInvalidUnitName is returned when a unit name is missing its singular or plural form.
+	ErrInvalidUnitName = errors.New("invalid unit name")
+	// ErrInvalidMoney is returned when operation gets Money which is not Valid, e.g. its zero value.
+	ErrInvalidMoney = errors.New("invalid money")
+)
+
+// CurrencyMismatchError is returned when Money of different currencies are combined.
+type CurrencyMismatchError struct {
+	Left  string
+	Right string
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s and %s", ErrCurrencyMismatch, e.Left, e.Right)
+}
+
+// Is reports whether target is ErrCurrencyMismatch.
+func (e *CurrencyMismatchError) Is(target error) bool {
+	return target == ErrCurrencyMismatch
+}
+
+// RuleError is returned when an allocation rule can't be evaluated.
+type RuleError struct {
+	Rule string
+	Err  error
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("rule %q: %v", e.Rule, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrInvalidRule.
+func (e *RuleError) Is(target error) bool {
+	return target == ErrInvalidRule
+}
@@ -0,0 +1,109 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestErrors_CurrencyMismatch(t *testing.T) {
+	eur := New(100, "EUR")
+	usd := New(100, "USD")
+
+	_, errEquals := eur.Equals(usd)
+	_, errGreater := eur.GreaterThan(usd)
+	_, errAdd := eur.Add(usd)
+	_, errSubtract := eur.Subtract(usd)
+	_, errSum := Sum(eur, usd)
+	_, errProRata := eur.AllocateProRata([]*Money{eur, usd})
+	_, errValue := eur.Value().Add(usd.Value())
+	_, errRule := eur.AllocateRules(FixedRule("partner", usd))
+
+	for i, err := range []error{errEquals, errGreater, errAdd, errSubtract, errSum, errProRata, errValue, errRule} {
+		if !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("%d: expected %v got %v", i, ErrCurrencyMismatch, err)
+		}
+
+		var cme *CurrencyMismatchError
+		if !errors.As(err, &cme) || cme.Left != "EUR" || cme.Right != "USD" {
+			t.Errorf("%d: expected currency mismatch of EUR and USD got %v", i, err)
+		}
+	}
+}
+
+func TestErrors_Sentinels(t *testing.T) {
+	m := New(100, "EUR")
+	broken := func(leftover int64, parties []AllocationParty) []int64 {
+		return nil
+	}
+
+	_, errSplit := m.Split(0)
+	_, errAllocate := m.Allocate()
+	_, errRatio := m.Allocate(1, -1)
+	_, errZero := m.Allocate(0, 0)
+	_, errWeight := m.AllocateWeights([]*big.Rat{big.NewRat(-1, 2)})
+	_, errPercent := m.AllocateByPercent("50", "x")
+	_, errPercentSum := m.AllocateByPercent("50", "40")
+	_, errRemainder := m.AllocateWith(broken, 1, 2)
+	_, errNegative := New(-100, "EUR").AllocateRules(RemainderRule("us"))
+	_, errEmpty := Max()
+	_, errOverflow := Sum(New(math.MaxInt64, "EUR"), New(1, "EUR"))
+	_, errRate := m.Convert("USD", NewRates())
+
+	tcs := []struct {
+		err      error
+		expected error
+	}{
+		{errSplit, ErrInvalidSplit},
+		{errAllocate, ErrNoRatios},
+		{errRatio, ErrInvalidRatio},
+		{errZero, ErrZeroRatios},
+		{errWeight, ErrInvalidRatio},
+		{errPercent, ErrInvalidPercentage},
+		{errPercentSum, ErrInvalidPercentage},
+		{errRemainder, ErrInvalidRemainder},
+		{errNegative, ErrNegativeAmount},
+		{errEmpty, ErrEmpty},
+		{errOverflow, ErrOverflow},
+		{errRate, ErrNoRate},
+	}
+
+	for i, tc := range tcs {
+		if !errors.Is(tc.err, tc.expected) {
+			t.Errorf("%d: expected %v got %v", i, tc.expected, tc.err)
+		}
+	}
+}
+
+func TestErrors_Rule(t *testing.T) {
+	_, err := New(100, "EUR").AllocateRules(FixedRule("partner", New(500, "EUR")))
+
+	var re *RuleError
+	if !errors.Is(err, ErrInvalidRule) || !errors.As(err, &re) || re.Rule != "partner" {
+		t.Errorf("Expected rule error for partner got %v", err)
+	}
+}
+
+func TestErrors_JSON(t *testing.T) {
+	UnmarshalJSON = defaultUnmarshalJSON
+
+	for _, given := range []string{
+		`{"AmountData": "10", "CurrencyData": "EUR"}`,
+		`{"AmountData": 10}`,
+		`[1, 2]`,
+	} {
+		var m Money
+		err := json.Unmarshal([]byte(given), &m)
+
+		if !errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("Expected %v for %s got %v", ErrInvalidJSON, given, err)
+		}
+	}
+
+	var v struct{ Price Money }
+	if err := json.Unmarshal([]byte(`{"Price": null}`), &v); err != nil || v.Price.Valid() {
+		t.Errorf("Expected null to leave Money unset got %v", err)
+	}
+}
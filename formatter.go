@@ -1,6 +1,8 @@
 package money
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -28,8 +30,8 @@ func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *F
 
 // Format returns string of formatted integer using given currency template.
 func (f *Formatter) Format(amount int64) string {
-	// Work with absolute amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+	// Work with absolute amount value, trimming the sign so math.MinInt64 doesn't overflow
+	sa := strings.TrimPrefix(strconv.FormatInt(amount, 10), "-")
 
 	if len(sa) <= f.Fraction {
 		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
@@ -55,6 +57,55 @@ func (f *Formatter) Format(amount int64) string {
 	return sa
 }
 
+// Parse returns integer amount of minor units of string formatted using the Formatter, e.g. "$1,234.56"
+// gives 123456. Grapheme and thousand separators are optional, the grapheme may only precede or follow
+// the number and number of digits after the decimal separator must not exceed Fraction.
+func (f *Formatter) Parse(s string) (int64, error) {
+	src := s
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	if f.Grapheme != "" && strings.HasPrefix(s, f.Grapheme) {
+		s = strings.TrimSpace(strings.TrimPrefix(s, f.Grapheme))
+	} else if f.Grapheme != "" {
+		s = strings.TrimSpace(strings.TrimSuffix(s, f.Grapheme))
+	}
+
+	if !neg && strings.HasPrefix(s, "-") {
+		neg, s = true, s[1:]
+	}
+
+	if f.Thousand != "" {
+		s = strings.Replace(s, f.Thousand, "", -1)
+	}
+
+	whole, frac := s, ""
+	if i := strings.Index(s, f.Decimal); f.Fraction > 0 && f.Decimal != "" && i >= 0 {
+		whole, frac = s[:i], s[i+len(f.Decimal):]
+	}
+
+	if len(frac) > f.Fraction || !isDigits(whole+frac) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, src)
+	}
+
+	digits := whole + frac + strings.Repeat("0", f.Fraction-len(frac))
+	if neg {
+		// Sign is parsed with the digits so math.MinInt64 doesn't overflow.
+		digits = "-" + digits
+	}
+
+	v, err := strconv.ParseInt(digits, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%w: %q", ErrOverflow, src)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, src)
+	}
+
+	return v, nil
+}
+
 // ToMajorUnits returns float64 representing the value in sub units using the currency data
 func (f *Formatter) ToMajorUnits(amount int64) float64 {
 	if f.Fraction == 0 {
@@ -64,11 +115,13 @@ func (f *Formatter) ToMajorUnits(amount int64) float64 {
 	return float64(amount) / float64(math.Pow10(f.Fraction))
 }
 
-// abs return absolute value of given integer.
-func (f Formatter) abs(amount int64) int64 {
-	if amount < 0 {
-		return -amount
+// isDigits reports whether s is a non empty string of ASCII digits.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
 	}
 
-	return amount
+	return s != ""
 }
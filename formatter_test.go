@@ -1,6 +1,8 @@
 package money
 
 import (
+	"errors"
+	"math"
 	"testing"
 )
 
@@ -167,3 +169,75 @@ func TestFormatter_ToMajorUnits(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatter_Parse(t *testing.T) {
+	tcs := []struct {
+		fraction int
+		decimal  string
+		thousand string
+		grapheme string
+		template string
+		input    string
+		expected int64
+	}{
+		{2, ".", ",", "$", "$1", "$1,234.56", 123456},
+		{2, ".", ",", "$", "$1", "1234.56", 123456},
+		{2, ".", ",", "$", "$1", "-$1,234.56", -123456},
+		{2, ".", ",", "$", "$1", "$-12.3", -1230},
+		{2, ".", ",", "$", "$1", " 12 ", 1200},
+		{2, ".", ",", "$", "$1", ".5", 50},
+		{2, ",", ".", "\u20ac", "1 $", "1.234,56 \u20ac", 123456},
+		{2, ",", " ", "z\u0142", "1 $", "1 234,56 z\u0142", 123456},
+		{0, ".", ",", "\u00a5", "$1", "\u00a51,234", 1234},
+		{3, ".", ",", ".\u062f.\u0628", "1 $", "1.500 .\u062f.\u0628", 1500},
+		{2, ".", ",", "$", "$1", "-$92,233,720,368,547,758.08", math.MinInt64},
+		{2, ".", ",", "$", "$1", "$92,233,720,368,547,758.07", math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(tc.fraction, tc.decimal, tc.thousand, tc.grapheme, tc.template)
+		r, err := formatter.Parse(tc.input)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %q to be parsed as %d got %d %v", tc.input, tc.expected, r, err)
+		}
+	}
+}
+
+func TestFormatter_ParseFormatted(t *testing.T) {
+	for _, code := range []string{"USD", "EUR", "PLN", "JPY", "BHD", "CHF", "XYZ"} {
+		f := newCurrency(code).get().Formatter()
+		for _, amount := range []int64{0, 1, -1, 999, 123456789, -987654321, math.MinInt64, math.MaxInt64} {
+			r, err := f.Parse(f.Format(amount))
+			if err != nil || r != amount {
+				t.Errorf("Expected %s formatted as %q to be parsed as %d got %d %v", code, f.Format(amount), amount, r, err)
+			}
+		}
+	}
+}
+
+func TestFormatter_ParseErrors(t *testing.T) {
+	tcs := []struct {
+		input    string
+		expected error
+	}{
+		{"", ErrInvalidAmount},
+		{"$", ErrInvalidAmount},
+		{"abc", ErrInvalidAmount},
+		{"1.234", ErrInvalidAmount},
+		{"1.2.3", ErrInvalidAmount},
+		{"--1", ErrInvalidAmount},
+		{"1e5", ErrInvalidAmount},
+		{"92,233,720,368,547,758.08", ErrOverflow},
+		{"-92,233,720,368,547,758.09", ErrOverflow},
+		{"12$34", ErrInvalidAmount},
+		{"$1$", ErrInvalidAmount},
+		{"-$-1", ErrInvalidAmount},
+	}
+
+	formatter := NewFormatter(2, ".", ",", "$", "$1")
+	for _, tc := range tcs {
+		if _, err := formatter.Parse(tc.input); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %q to return %v got %v", tc.input, tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package money
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Sinojin/go-money/internal/calendar"
+)
+
+// Interval is the time between due dates of installments.
+type Interval int
+
+const (
+	// IntervalMonthly makes installments due every month on the day of month of the start date,
+	// or on the last day of shorter months.
+	IntervalMonthly Interval = iota
+	// IntervalBiWeekly makes installments due every two weeks.
+	IntervalBiWeekly
+	// IntervalCustom makes installments due on the given dates.
+	IntervalCustom
+)
+
+// InstallmentOptions describe how NewInstallmentPlan splits the total.
+type InstallmentOptions struct {
+	// Count is the number of installments, not counting the down payment.
+	Count int
+	// DownPayment is paid on the start date before the installments, if given.
+	DownPayment *Money
+	// First is the amount of the first installment, if it differs from the others.
+	First *Money
+	// Last is the amount of the last installment, if it differs from the others.
+	Last *Money
+	// Start is the date of the plan. Installments are due one interval after each other, starting
+	// one interval after Start.
+	Start time.Time
+	// Interval between the due dates.
+	Interval Interval
+	// Dates are the due dates of installments for IntervalCustom.
+	Dates []time.Time
+}
+
+// Installment is a single dated payment of InstallmentPlan.
+type Installment struct {
+	// Number is the number of the installment starting from 1, the down payment has number 0.
+	Number int
+	Due    time.Time
+	Amount *Money
+}
+
+// InstallmentPlan is the total split into dated installments. It can be stored using encoding/json.
+type InstallmentPlan struct {
+	Total        *Money
+	Installments []Installment
+}
+
+// NewInstallmentPlan splits total into installments described by given options.
+// The down payment, first and last installments are taken as given and the rest is
+// split equally between the other installments, leftover pennies going to the earlier ones.
+func NewInstallmentPlan(total *Money, o InstallmentOptions) (*InstallmentPlan, error) {
+	if total.IsNegative() {
+		return nil, ErrNegativeAmount
+	}
+
+	if o.Count <= 0 {
+		return nil, fmt.Errorf("%w: installment count must be higher than zero", ErrInvalidSplit)
+	}
+
+	dates, err := o.dates()
+	if err != nil {
+		return nil, err
+	}
+
+	if o.First != nil && o.Last != nil && o.Count == 1 {
+		return nil, fmt.Errorf("%w: single installment can't be both first and last", ErrInvalidSplit)
+	}
+
+	rest := total.Amount()
+	others := o.Count
+	fixed := make(map[int]*Money)
+	for _, f := range []struct {
+		n  int
+		om *Money
+	}{{0, o.DownPayment}, {1, o.First}, {o.Count, o.Last}} {
+		n, om := f.n, f.om
+		if om == nil {
+			continue
+		}
+
+		if err := total.assertSameCurrencyData(om); err != nil {
+			return nil, err
+		}
+
+		if om.IsNegative() {
+			return nil, ErrNegativeAmount
+		}
+
+		if n > 0 {
+			others--
+		}
+
+		fixed[n] = om.withAmount(om.Amount())
+		rest -= om.Amount()
+	}
+
+	if rest < 0 {
+		return nil, fmt.Errorf("%w: installments exceed total %s", ErrInvalidSplit, total.Display())
+	}
+
+	var parts []*Money
+	if others > 0 {
+		if parts, err = total.withAmount(rest).Split(others); err != nil {
+			return nil, err
+		}
+	} else if rest != 0 {
+		return nil, fmt.Errorf("%w: installments don't sum to total %s", ErrInvalidSplit, total.Display())
+	}
+
+	p := &InstallmentPlan{Total: total.withAmount(total.Amount())}
+	for n := 0; n <= o.Count; n++ {
+		amount, ok := fixed[n]
+		if !ok && n == 0 {
+			continue
+		}
+
+		if !ok {
+			amount, parts = parts[0], parts[1:]
+		}
+
+		p.Installments = append(p.Installments, Installment{Number: n, Due: dates[n], Amount: amount})
+	}
+
+	return p, nil
+}
+
+// dates returns the due date of the down payment followed by due dates of the installments.
+func (o InstallmentOptions) dates() ([]time.Time, error) {
+	ds := []time.Time{o.Start}
+	switch o.Interval {
+	case IntervalMonthly:
+		for n := 1; n <= o.Count; n++ {
+			ds = append(ds, calendar.AddMonths(o.Start, n))
+		}
+	case IntervalBiWeekly:
+		for n := 1; n <= o.Count; n++ {
+			ds = append(ds, o.Start.AddDate(0, 0, 14*n))
+		}
+	case IntervalCustom:
+		if len(o.Dates) != o.Count {
+			return nil, fmt.Errorf("%w: %d dates given for %d installments", ErrInvalidSchedule, len(o.Dates), o.Count)
+		}
+
+		for i, d := range o.Dates {
+			if d.Before(ds[i]) {
+				return nil, fmt.Errorf("%w: due dates must be in order", ErrInvalidSchedule)
+			}
+
+			ds = append(ds, d)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown interval %d", ErrInvalidSchedule, o.Interval)
+	}
+
+	return ds, nil
+}
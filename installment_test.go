@@ -0,0 +1,175 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func installmentAmounts(p *InstallmentPlan) []int64 {
+	var rs []int64
+	for _, i := range p.Installments {
+		rs = append(rs, i.Amount.Amount())
+	}
+
+	return rs
+}
+
+func TestNewInstallmentPlan(t *testing.T) {
+	tcs := []struct {
+		total    int64
+		opts     InstallmentOptions
+		numbers  []int
+		expected []int64
+	}{
+		{1000, InstallmentOptions{Count: 3}, []int{1, 2, 3}, []int64{334, 333, 333}},
+		{1000, InstallmentOptions{Count: 3, DownPayment: New(100, "EUR")}, []int{0, 1, 2, 3}, []int64{100, 300, 300, 300}},
+		{1000, InstallmentOptions{Count: 3, First: New(500, "EUR")}, []int{1, 2, 3}, []int64{500, 250, 250}},
+		{1000, InstallmentOptions{Count: 3, Last: New(1, "EUR")}, []int{1, 2, 3}, []int64{500, 499, 1}},
+		{1000, InstallmentOptions{Count: 4, DownPayment: New(101, "EUR"), First: New(99, "EUR"), Last: New(400, "EUR")},
+			[]int{0, 1, 2, 3, 4}, []int64{101, 99, 200, 200, 400}},
+		{1000, InstallmentOptions{Count: 2, First: New(600, "EUR"), Last: New(400, "EUR")}, []int{1, 2}, []int64{600, 400}},
+		{0, InstallmentOptions{Count: 2}, []int{1, 2}, []int64{0, 0}},
+	}
+
+	for _, tc := range tcs {
+		p, err := NewInstallmentPlan(New(tc.total, "EUR"), tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var numbers []int
+		for _, i := range p.Installments {
+			numbers = append(numbers, i.Number)
+		}
+
+		if !reflect.DeepEqual(numbers, tc.numbers) || !reflect.DeepEqual(installmentAmounts(p), tc.expected) {
+			t.Errorf("Expected %d split into %v %v got %v %v", tc.total, tc.numbers, tc.expected, numbers,
+				installmentAmounts(p))
+		}
+	}
+}
+
+func TestNewInstallmentPlan_Dates(t *testing.T) {
+	tcs := []struct {
+		opts     InstallmentOptions
+		expected []time.Time
+	}{
+		{
+			InstallmentOptions{Count: 3, Start: date(2024, 1, 31), DownPayment: New(0, "EUR")},
+			[]time.Time{date(2024, 1, 31), date(2024, 2, 29), date(2024, 3, 31), date(2024, 4, 30)},
+		},
+		{
+			InstallmentOptions{Count: 2, Start: date(2024, 12, 15), Interval: IntervalBiWeekly},
+			[]time.Time{date(2024, 12, 29), date(2025, 1, 12)},
+		},
+		{
+			InstallmentOptions{Count: 2, Start: date(2024, 1, 1), Interval: IntervalCustom,
+				Dates: []time.Time{date(2024, 1, 1), date(2024, 6, 1)}},
+			[]time.Time{date(2024, 1, 1), date(2024, 6, 1)},
+		},
+	}
+
+	for _, tc := range tcs {
+		p, err := NewInstallmentPlan(New(900, "EUR"), tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var dates []time.Time
+		for _, i := range p.Installments {
+			dates = append(dates, i.Due)
+		}
+
+		if !reflect.DeepEqual(dates, tc.expected) {
+			t.Errorf("Expected due dates %v got %v", tc.expected, dates)
+		}
+	}
+}
+
+func TestNewInstallmentPlan_Errors(t *testing.T) {
+	tcs := []struct {
+		total    *Money
+		opts     InstallmentOptions
+		expected error
+	}{
+		{New(-100, "EUR"), InstallmentOptions{Count: 1}, ErrNegativeAmount},
+		{New(100, "EUR"), InstallmentOptions{}, ErrInvalidSplit},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, DownPayment: New(101, "EUR")}, ErrInvalidSplit},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, First: New(10, "EUR"), Last: New(10, "EUR")}, ErrInvalidSplit},
+		{New(100, "EUR"), InstallmentOptions{Count: 1, First: New(50, "EUR"), Last: New(50, "EUR")}, ErrInvalidSplit},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, First: New(-10, "EUR")}, ErrNegativeAmount},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, DownPayment: New(10, "USD")}, ErrCurrencyMismatch},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, Interval: IntervalCustom}, ErrInvalidSchedule},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, Interval: IntervalCustom, Start: date(2024, 1, 1),
+			Dates: []time.Time{date(2024, 3, 1), date(2024, 2, 1)}}, ErrInvalidSchedule},
+		{New(100, "EUR"), InstallmentOptions{Count: 2, Interval: 7}, ErrInvalidSchedule},
+	}
+
+	for i, tc := range tcs {
+		p, err := NewInstallmentPlan(tc.total, tc.opts)
+		if p != nil || !errors.Is(err, tc.expected) {
+			t.Errorf("%d: expected %v got %v", i, tc.expected, err)
+		}
+	}
+}
+
+func TestNewInstallmentPlan_Copies(t *testing.T) {
+	o := InstallmentOptions{Count: 2, DownPayment: New(100, "EUR"), First: New(200, "EUR"), Last: New(300, "EUR")}
+	total := New(600, "EUR")
+	p, err := NewInstallmentPlan(total, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, om := range []*Money{o.DownPayment, o.First, o.Last} {
+		if r := p.Installments[i].Amount; r == om || r.Amount() != om.Amount() {
+			t.Errorf("Expected installment %d to be a copy of %d got %p of %p", i, om.Amount(), r, om)
+		}
+	}
+
+	total.AmountData.Val = 700
+	o.DownPayment.AmountData.Val = 200
+	if p.Total.Amount() != 600 || p.Installments[0].Amount.Amount() != 100 {
+		t.Errorf("Expected plan of %d with down payment %d got %d and %d", 600, 100, p.Total.Amount(),
+			p.Installments[0].Amount.Amount())
+	}
+}
+
+func TestInstallmentPlan_JSON(t *testing.T) {
+	p, err := NewInstallmentPlan(New(1000, "EUR"), InstallmentOptions{
+		Count:       2,
+		DownPayment: New(200, "EUR"),
+		Start:       date(2024, 1, 31),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r InstallmentPlan
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, _ := r.Total.Equals(p.Total); !ok || len(r.Installments) != len(p.Installments) {
+		t.Fatalf("Expected %s to round trip got %+v", b, r)
+	}
+
+	for i, in := range r.Installments {
+		exp := p.Installments[i]
+		if ok, _ := in.Amount.Equals(exp.Amount); !ok || in.Number != exp.Number || !in.Due.Equal(exp.Due) {
+			t.Errorf("Expected installment %+v got %+v", exp, in)
+		}
+	}
+}
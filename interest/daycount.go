@@ -0,0 +1,114 @@
+package interest
+
+import (
+	"math/big"
+	"time"
+)
+
+// DayCount is a day count convention converting accrual periods to fractions of a year.
+type DayCount interface {
+	// Days returns number of days between start and end counted by the convention.
+	Days(start, end time.Time) int64
+	// YearFraction returns the exact fraction of a year between start and end.
+	YearFraction(start, end time.Time) *big.Rat
+	// String returns the name of the convention, e.g. "ACT/360".
+	String() string
+}
+
+var (
+	// Actual360 counts actual days over a 360 day year.
+	Actual360 DayCount = actualFixed(360)
+	// Actual365 counts actual days over a 365 day year.
+	Actual365 DayCount = actualFixed(365)
+	// Thirty360 counts 30 day months over a 360 day year (bond basis).
+	Thirty360 DayCount = thirty360{}
+	// ActualActual counts actual days over the actual length of every calendar year (ISDA).
+	ActualActual DayCount = actualActual{}
+)
+
+type actualFixed int64
+
+func (dc actualFixed) Days(start, end time.Time) int64 {
+	return days(start, end)
+}
+
+func (dc actualFixed) YearFraction(start, end time.Time) *big.Rat {
+	return big.NewRat(days(start, end), int64(dc))
+}
+
+func (dc actualFixed) String() string {
+	if dc == 360 {
+		return "ACT/360"
+	}
+
+	return "ACT/365"
+}
+
+type thirty360 struct{}
+
+func (thirty360) Days(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + d2 - d1)
+}
+
+func (dc thirty360) YearFraction(start, end time.Time) *big.Rat {
+	return big.NewRat(dc.Days(start, end), 360)
+}
+
+func (thirty360) String() string {
+	return "30/360"
+}
+
+type actualActual struct{}
+
+func (actualActual) Days(start, end time.Time) int64 {
+	return days(start, end)
+}
+
+func (actualActual) YearFraction(start, end time.Time) *big.Rat {
+	if end.Before(start) {
+		return new(big.Rat).Neg(actualActual{}.YearFraction(end, start))
+	}
+
+	yf := new(big.Rat)
+	for y := start.Year(); y <= end.Year(); y++ {
+		from, to := date(y, time.January, 1), date(y+1, time.January, 1)
+		length := days(from, to)
+		if y == start.Year() {
+			from = start
+		}
+
+		if y == end.Year() {
+			to = end
+		}
+
+		yf.Add(yf, big.NewRat(days(from, to), length))
+	}
+
+	return yf
+}
+
+func (actualActual) String() string {
+	return "ACT/ACT"
+}
+
+// days returns number of calendar days between dates of start and end, ignoring time of day and location.
+func days(start, end time.Time) int64 {
+	s := date(start.Date())
+	e := date(end.Date())
+
+	return (e.Unix() - s.Unix()) / 86400
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,72 @@
+package interest
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func d(y int, m time.Month, day int) time.Time {
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDayCount(t *testing.T) {
+	tcs := []struct {
+		dc       DayCount
+		start    time.Time
+		end      time.Time
+		days     int64
+		fraction *big.Rat
+	}{
+		{Actual360, d(2024, 1, 1), d(2024, 7, 1), 182, big.NewRat(182, 360)},
+		{Actual365, d(2024, 1, 1), d(2024, 7, 1), 182, big.NewRat(182, 365)},
+		{Thirty360, d(2024, 1, 1), d(2024, 7, 1), 180, big.NewRat(1, 2)},
+		{Thirty360, d(2024, 1, 31), d(2024, 3, 31), 60, big.NewRat(60, 360)},
+		{Thirty360, d(2024, 1, 15), d(2024, 3, 31), 76, big.NewRat(76, 360)},
+		{Thirty360, d(2024, 2, 29), d(2024, 3, 31), 32, big.NewRat(32, 360)},
+		{ActualActual, d(2024, 1, 1), d(2025, 1, 1), 366, big.NewRat(1, 1)},
+		{ActualActual, d(2023, 7, 1), d(2024, 7, 1), 366,
+			new(big.Rat).Add(big.NewRat(184, 365), big.NewRat(182, 366))},
+		{ActualActual, d(2024, 7, 1), d(2023, 7, 1), -366,
+			new(big.Rat).Neg(new(big.Rat).Add(big.NewRat(184, 365), big.NewRat(182, 366)))},
+		{Actual360, d(2024, 3, 1), d(2024, 3, 1), 0, new(big.Rat)},
+	}
+
+	for _, tc := range tcs {
+		if days := tc.dc.Days(tc.start, tc.end); days != tc.days {
+			t.Errorf("Expected %s days %d got %d", tc.dc, tc.days, days)
+		}
+
+		if f := tc.dc.YearFraction(tc.start, tc.end); f.Cmp(tc.fraction) != 0 {
+			t.Errorf("Expected %s year fraction %s got %s", tc.dc, tc.fraction, f)
+		}
+	}
+}
+
+func TestDayCount_IgnoresTimeOfDay(t *testing.T) {
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	start := time.Date(2024, 3, 30, 23, 0, 0, 0, loc)
+	end := time.Date(2024, 4, 2, 1, 0, 0, 0, loc)
+
+	if days := Actual365.Days(start, end); days != 3 {
+		t.Errorf("Expected %d got %d", 3, days)
+	}
+}
+
+func TestDayCount_String(t *testing.T) {
+	tcs := []struct {
+		dc       DayCount
+		expected string
+	}{
+		{Actual360, "ACT/360"},
+		{Actual365, "ACT/365"},
+		{Thirty360, "30/360"},
+		{ActualActual, "ACT/ACT"},
+	}
+
+	for _, tc := range tcs {
+		if tc.dc.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, tc.dc.String())
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Package interest accrues simple and compound interest on money.Money balances.
+//
+// Accrual periods follow the compounding frequency and are converted to fractions of a year
+// with a day count convention such as ACT/360 or 30/360. Interest is calculated exactly and
+// rounded to minor units either every period or once at the end, and every result comes with
+// a per-period schedule whose interest sums to the total exactly.
+package interest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/amount"
+	"github.com/Sinojin/go-money/internal/calendar"
+	"github.com/Sinojin/go-money/internal/percent"
+)
+
+var (
+	// ErrInvalidRate is returned when a rate percentage can't be parsed.
+	ErrInvalidRate = errors.New("invalid interest rate")
+	// ErrInvalidFrequency is returned when a frequency doesn't divide a year into whole months, weeks or days.
+	ErrInvalidFrequency = errors.New("invalid frequency")
+	// ErrInvalidPeriod is returned when the end of accrual is before its start.
+	ErrInvalidPeriod = errors.New("end must not be before start")
+)
+
+// Frequency is the number of periods per year.
+type Frequency int
+
+const (
+	// AtMaturity accrues a single period from start to end.
+	AtMaturity Frequency = 0
+	// Annually has one period per year.
+	Annually Frequency = 1
+	// SemiAnnually has two periods per year.
+	SemiAnnually Frequency = 2
+	// Quarterly has four periods per year.
+	Quarterly Frequency = 4
+	// Monthly has twelve periods per year.
+	Monthly Frequency = 12
+	// Weekly has periods of seven days.
+	Weekly Frequency = 52
+	// Daily has periods of a single day.
+	Daily Frequency = 365
+)
+
+// Add returns the date n periods after t. Month based frequencies keep the day of month of t,
+// clamped to the end of shorter months, e.g. a month after January 31 is February 28 or 29.
+// Unknown frequencies return t.
+func (f Frequency) Add(t time.Time, n int) time.Time {
+	switch {
+	case f == Daily:
+		return t.AddDate(0, 0, n)
+	case f == Weekly:
+		return t.AddDate(0, 0, 7*n)
+	case f > 0 && 12%int(f) == 0:
+		return calendar.AddMonths(t, n*12/int(f))
+	}
+
+	return t
+}
+
+// Valid reports whether f is AtMaturity or divides a year into whole months, weeks or days.
+func (f Frequency) Valid() bool {
+	return f == AtMaturity || f == Daily || f == Weekly || f > 0 && 12%int(f) == 0
+}
+
+// dates returns period boundaries from start to end. The last period is shortened to end at end.
+func (f Frequency) dates(start, end time.Time) ([]time.Time, error) {
+	if !f.Valid() {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidFrequency, f)
+	}
+
+	if end.Before(start) {
+		return nil, ErrInvalidPeriod
+	}
+
+	ds := []time.Time{start}
+	for n := 1; f != AtMaturity; n++ {
+		d := f.Add(start, n)
+		if !d.Before(end) {
+			break
+		}
+
+		ds = append(ds, d)
+	}
+
+	if end.After(start) {
+		ds = append(ds, end)
+	}
+
+	return ds, nil
+}
+
+// Policy specifies where interest is rounded.
+type Policy int
+
+const (
+	// PerPeriod rounds interest of every period, compound interest is charged on the rounded balance.
+	PerPeriod Policy = iota
+	// AtEnd accrues exact interest and rounds only the running total, so the total is rounded once.
+	AtEnd
+)
+
+// Terms describe how interest accrues.
+type Terms struct {
+	// Rate is the nominal annual rate in percent, e.g. "5" or "4.25%".
+	Rate string
+	// DayCount converts periods to fractions of a year, Actual365 when nil.
+	DayCount DayCount
+	// Frequency of accrual periods, and of compounding for compound interest.
+	Frequency Frequency
+	// Policy specifies where interest is rounded.
+	Policy Policy
+	// Mode is used to round interest to minor units.
+	Mode money.RoundingMode
+}
+
+// Period is a row of the accrual schedule.
+type Period struct {
+	Start    time.Time
+	End      time.Time
+	Days     int64
+	Fraction *big.Rat
+	Opening  *money.Money
+	Interest *money.Money
+	Closing  *money.Money
+}
+
+// Result holds accrued interest and its schedule.
+type Result struct {
+	Principal *money.Money
+	Interest  *money.Money
+	Balance   *money.Money
+	Periods   []Period
+}
+
+// Simple accrues interest on principal from start to end. Interest of every period is charged
+// on the principal only.
+func Simple(principal *money.Money, t Terms, start, end time.Time) (*Result, error) {
+	return accrue(principal, t, start, end, false)
+}
+
+// Compound accrues interest on principal from start to end. Interest is added to the balance
+// at the end of every period and is charged interest in the following periods.
+func Compound(principal *money.Money, t Terms, start, end time.Time) (*Result, error) {
+	return accrue(principal, t, start, end, true)
+}
+
+func accrue(principal *money.Money, t Terms, start, end time.Time, compound bool) (*Result, error) {
+	rate, err := t.rate()
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := t.Frequency.dates(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	dc := t.DayCount
+	if dc == nil {
+		dc = Actual365
+	}
+
+	code := principal.Currency().Code
+	res := &Result{Principal: principal}
+	balance := principal.Amount()
+	cum, prev := new(big.Rat), int64(0)
+	for i := 1; i < len(ds); i++ {
+		yf := dc.YearFraction(ds[i-1], ds[i])
+		f := new(big.Rat).Mul(rate, yf)
+
+		var accrued int64
+		switch t.Policy {
+		case AtEnd:
+			base := new(big.Rat).SetInt64(principal.Amount())
+			if compound {
+				base.Add(base, cum)
+			}
+
+			cum.Add(cum, base.Mul(base, f))
+			r, err := amount.Round(cum, code, t.Mode)
+			if err != nil {
+				return nil, err
+			}
+
+			accrued, prev = r-prev, r
+		default:
+			base := principal.Amount()
+			if compound {
+				base = balance
+			}
+
+			accrued, err = amount.Round(new(big.Rat).Mul(new(big.Rat).SetInt64(base), f), code, t.Mode)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res.Periods = append(res.Periods, Period{
+			Start:    ds[i-1],
+			End:      ds[i],
+			Days:     dc.Days(ds[i-1], ds[i]),
+			Fraction: yf,
+			Opening:  money.New(balance, code),
+			Interest: money.New(accrued, code),
+			Closing:  money.New(balance+accrued, code),
+		})
+		balance += accrued
+	}
+
+	res.Interest = money.New(balance-principal.Amount(), code)
+	res.Balance = money.New(balance, code)
+
+	return res, nil
+}
+
+// rate returns rate as a fraction, e.g. 0.05 for 5%.
+func (t Terms) rate() (*big.Rat, error) {
+	r, ok := percent.Parse(t.Rate)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRate, t.Rate)
+	}
+
+	return r.Quo(r, big.NewRat(100, 1)), nil
+}
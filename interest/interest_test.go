@@ -0,0 +1,173 @@
+package interest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestSimple(t *testing.T) {
+	tcs := []struct {
+		terms    Terms
+		expected int64
+		periods  int
+	}{
+		{Terms{Rate: "5", DayCount: Actual360}, 50833, 1},
+		{Terms{Rate: "5%", DayCount: Actual360, Frequency: Monthly}, 50838, 12},
+		{Terms{Rate: "5", DayCount: Actual360, Frequency: Monthly, Policy: AtEnd}, 50833, 12},
+		{Terms{Rate: "5", DayCount: Thirty360, Frequency: Quarterly}, 50000, 4},
+		{Terms{Rate: "5", DayCount: Actual360, Mode: money.RoundDown}, 50833, 1},
+		{Terms{Rate: "5", DayCount: Actual360, Mode: money.RoundUp}, 50834, 1},
+		{Terms{Rate: "5"}, 50137, 1},
+	}
+
+	for _, tc := range tcs {
+		res, err := Simple(money.New(1000000, "USD"), tc.terms, d(2024, 1, 1), d(2025, 1, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Interest.Amount() != tc.expected || len(res.Periods) != tc.periods {
+			t.Errorf("Expected %+v to accrue %d in %d periods got %d in %d", tc.terms, tc.expected, tc.periods,
+				res.Interest.Amount(), len(res.Periods))
+		}
+
+		if res.Balance.Amount() != 1000000+tc.expected {
+			t.Errorf("Expected balance %d got %d", 1000000+tc.expected, res.Balance.Amount())
+		}
+	}
+}
+
+func TestCompound(t *testing.T) {
+	tcs := []struct {
+		policy   Policy
+		expected []int64
+		total    int64
+	}{
+		{PerPeriod, []int64{4247, 3989, 4282, 4161, 4317, 4196, 4354, 4372, 4249, 4409, 4285, 4446}, 51307},
+		{AtEnd, []int64{4247, 3989, 4282, 4161, 4317, 4196, 4353, 4373, 4248, 4409, 4285, 4445}, 51305},
+	}
+
+	for _, tc := range tcs {
+		terms := Terms{Rate: "5", DayCount: Actual365, Frequency: Monthly, Policy: tc.policy}
+		res, err := Compound(money.New(1000000, "EUR"), terms, d(2024, 1, 1), d(2025, 1, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var rs []int64
+		for _, p := range res.Periods {
+			rs = append(rs, p.Interest.Amount())
+		}
+
+		if !reflect.DeepEqual(rs, tc.expected) || res.Interest.Amount() != tc.total {
+			t.Errorf("Expected %v = %d got %v = %d", tc.expected, tc.total, rs, res.Interest.Amount())
+		}
+
+		for i, p := range res.Periods {
+			if p.Opening.Amount()+p.Interest.Amount() != p.Closing.Amount() {
+				t.Errorf("Expected period %d %d + %d = %d", i, p.Opening.Amount(), p.Interest.Amount(),
+					p.Closing.Amount())
+			}
+
+			if i > 0 && p.Opening.Amount() != res.Periods[i-1].Closing.Amount() {
+				t.Errorf("Expected period %d to open with %d got %d", i, res.Periods[i-1].Closing.Amount(),
+					p.Opening.Amount())
+			}
+		}
+	}
+}
+
+func TestCompound_Schedule(t *testing.T) {
+	terms := Terms{Rate: "6", DayCount: Thirty360, Frequency: Monthly}
+	res, err := Compound(money.New(100000, "USD"), terms, d(2024, 1, 31), d(2024, 5, 15))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []time.Time{d(2024, 1, 31), d(2024, 2, 29), d(2024, 3, 31), d(2024, 4, 30), d(2024, 5, 15)}
+	for i, p := range res.Periods {
+		if !p.Start.Equal(expected[i]) || !p.End.Equal(expected[i+1]) {
+			t.Errorf("Expected period %d from %s to %s got %s to %s", i, expected[i], expected[i+1], p.Start, p.End)
+		}
+	}
+
+	if len(res.Periods) != 4 {
+		t.Fatalf("Expected %d periods got %d", 4, len(res.Periods))
+	}
+
+	tcs := []struct {
+		days     int64
+		expected int64
+	}{
+		{29, 483},
+		{32, 536},
+		{30, 505},
+		{15, 254},
+	}
+
+	for i, tc := range tcs {
+		if p := res.Periods[i]; p.Days != tc.days || p.Interest.Amount() != tc.expected {
+			t.Errorf("Expected period %d to accrue %d in %d days got %d in %d", i, tc.expected, tc.days,
+				p.Interest.Amount(), p.Days)
+		}
+	}
+}
+
+func TestFrequency_Add(t *testing.T) {
+	tcs := []struct {
+		f        Frequency
+		n        int
+		expected time.Time
+	}{
+		{Monthly, 1, d(2024, 2, 29)},
+		{Monthly, 2, d(2024, 3, 31)},
+		{Monthly, 13, d(2025, 2, 28)},
+		{Quarterly, 1, d(2024, 4, 30)},
+		{Annually, 1, d(2025, 1, 31)},
+		{Weekly, 1, d(2024, 2, 7)},
+		{Daily, 1, d(2024, 2, 1)},
+		{Frequency(5), 1, d(2024, 1, 31)},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.f.Add(d(2024, 1, 31), tc.n); !r.Equal(tc.expected) {
+			t.Errorf("Expected %d periods of %d after 2024-01-31 to be %s got %s", tc.n, tc.f, tc.expected, r)
+		}
+	}
+}
+
+func TestAccrue_Errors(t *testing.T) {
+	tcs := []struct {
+		terms    Terms
+		start    time.Time
+		expected error
+	}{
+		{Terms{Rate: "five"}, d(2024, 1, 1), ErrInvalidRate},
+		{Terms{Rate: "5", Frequency: 5}, d(2024, 1, 1), ErrInvalidFrequency},
+		{Terms{Rate: "5"}, d(2026, 1, 1), ErrInvalidPeriod},
+	}
+
+	for _, tc := range tcs {
+		for _, fn := range []func(*money.Money, Terms, time.Time, time.Time) (*Result, error){Simple, Compound} {
+			res, err := fn(money.New(100, "EUR"), tc.terms, tc.start, d(2025, 1, 1))
+			if res != nil || !errors.Is(err, tc.expected) {
+				t.Errorf("Expected %v got %v", tc.expected, err)
+			}
+		}
+	}
+}
+
+func TestAccrue_Empty(t *testing.T) {
+	res, err := Compound(money.New(100, "EUR"), Terms{Rate: "5", Frequency: Monthly}, d(2024, 1, 1), d(2024, 1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Periods) != 0 || !res.Interest.IsZero() || res.Balance.Amount() != 100 {
+		t.Errorf("Expected no interest got %v in %d periods", res.Interest.Amount(), len(res.Periods))
+	}
+}
@@ -0,0 +1,57 @@
+// Package amount parses, rounds and looks up currencies of amounts shared by the money packages.
+package amount
+
+import (
+	"strings"
+	"unicode"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// Parse parses formatted amount s of currency c into minor units. Whitespace is ignored and the
+// grapheme of the currency is optional. Decimal and thousand separators are detected unless given.
+func Parse(s string, c *money.Currency, decimal, thousand string) (int64, error) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, s)
+
+	d, t := Separators(strings.Replace(s, c.Grapheme, "", 1), c.Fraction)
+	if decimal != "" && decimal != d {
+		d, t = decimal, ""
+	}
+
+	if thousand != "" {
+		t = thousand
+	}
+
+	return money.NewFormatter(c.Fraction, d, t, c.Grapheme, "1").Parse(s)
+}
+
+// Separators detects decimal and thousand separators of amount s with given number of fraction
+// digits. The last of "." and "," is the decimal separator when the other one precedes it, or when
+// it occurs once and is followed by no more than fraction digits. Otherwise it separates thousands.
+func Separators(s string, fraction int) (string, string) {
+	i := strings.LastIndexAny(s, ".,")
+	if i < 0 {
+		return ".", ","
+	}
+
+	last, other := s[i:i+1], ","
+	if last == "," {
+		other = "."
+	}
+
+	if strings.Contains(s[:i], other) {
+		return last, other
+	}
+
+	if strings.Count(s, last) == 1 && fraction > 0 && len(s)-i-1 <= fraction {
+		return last, other
+	}
+
+	return other, last
+}
@@ -0,0 +1,46 @@
+package amount
+
+import (
+	"errors"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		s        string
+		code     string
+		decimal  string
+		thousand string
+		expected int64
+	}{
+		{"1.234,56 €", "EUR", "", "", 123456},
+		{"€1,234.56", "EUR", "", "", 123456},
+		{"1,234", "USD", "", "", 123400},
+		{"1,23", "USD", "", "", 123},
+		{"1.234.567", "EUR", "", "", 123456700},
+		{"1 234,56 zł", "PLN", "", "", 123456},
+		{"1.234", "JPY", "", "", 1234},
+		{"1,234", "BHD", "", "", 1234},
+		{"-12", "USD", "", "", -1200},
+		{"1'234.5", "CHF", "", "'", 123450},
+		{"1.234,5", "USD", ",", "", 123450},
+	}
+
+	for _, tc := range tcs {
+		a, err := Parse(tc.s, money.GetCurrency(tc.code), tc.decimal, tc.thousand)
+		if err != nil || a != tc.expected {
+			t.Errorf("Expected %q to be %d got %d %v", tc.s, tc.expected, a, err)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tcs := []string{"", "abc", "1,2,3.4.5", "1.234,567"}
+	for _, s := range tcs {
+		if _, err := Parse(s, money.GetCurrency("EUR"), "", ""); !errors.Is(err, money.ErrInvalidAmount) {
+			t.Errorf("Expected invalid amount for %q got %v", s, err)
+		}
+	}
+}
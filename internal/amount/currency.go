@@ -0,0 +1,42 @@
+package amount
+
+import (
+	"fmt"
+	"strings"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// CurrencyJSON is JSON representation of a currency.
+type CurrencyJSON struct {
+	Code          string `json:"code"`
+	Fraction      int    `json:"fraction"`
+	Grapheme      string `json:"grapheme"`
+	Template      string `json:"template"`
+	Decimal       string `json:"decimal"`
+	Thousand      string `json:"thousand"`
+	CashIncrement int64  `json:"cash_increment,omitempty"`
+}
+
+// NewCurrencyJSON returns JSON representation of currency c.
+func NewCurrencyJSON(c *money.Currency) CurrencyJSON {
+	return CurrencyJSON{
+		Code:          c.Code,
+		Fraction:      c.Fraction,
+		Grapheme:      c.Grapheme,
+		Template:      c.Template,
+		Decimal:       c.Decimal,
+		Thousand:      c.Thousand,
+		CashIncrement: c.CashIncrement,
+	}
+}
+
+// Currency returns known currency of given code in any case.
+func Currency(code string) (*money.Currency, error) {
+	c := money.GetCurrency(strings.ToUpper(code))
+	if c == nil {
+		return nil, fmt.Errorf("unknown currency %q", code)
+	}
+
+	return c, nil
+}
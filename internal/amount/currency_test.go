@@ -0,0 +1,46 @@
+package amount
+
+import (
+	"encoding/json"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestCurrency(t *testing.T) {
+	tcs := []struct {
+		code     string
+		expected string
+	}{
+		{"EUR", "EUR"},
+		{"eur", "EUR"},
+		{"chf", "CHF"},
+	}
+
+	for _, tc := range tcs {
+		c, err := Currency(tc.code)
+		if err != nil || c.Code != tc.expected {
+			t.Errorf("Expected %q to be %s got %v %v", tc.code, tc.expected, c, err)
+		}
+	}
+}
+
+func TestCurrency2(t *testing.T) {
+	for _, code := range []string{"", "XYZ"} {
+		if c, err := Currency(code); c != nil || err == nil {
+			t.Errorf("Expected %q to be unknown got %v", code, c)
+		}
+	}
+}
+
+func TestNewCurrencyJSON(t *testing.T) {
+	b, err := json.Marshal(NewCurrencyJSON(money.GetCurrency("CHF")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"code":"CHF","fraction":2,"grapheme":"CHF","template":"1 $","decimal":".","thousand":",","cash_increment":5}`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, b)
+	}
+}
@@ -0,0 +1,18 @@
+package amount
+
+import (
+	"math/big"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// Round returns exact number of minor units r of currency code rounded using given mode.
+// money.ErrOverflow is returned when the result doesn't fit int64.
+func Round(r *big.Rat, code string, mode money.RoundingMode) (int64, error) {
+	m, err := money.NewFromRat(r, code, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.Amount(), nil
+}
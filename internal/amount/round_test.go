@@ -0,0 +1,36 @@
+package amount
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestRound(t *testing.T) {
+	tcs := []struct {
+		r        *big.Rat
+		mode     money.RoundingMode
+		expected int64
+	}{
+		{big.NewRat(1000, 3), money.RoundHalfUp, 333},
+		{big.NewRat(2000, 3), money.RoundDown, 666},
+		{big.NewRat(-5, 2), money.RoundHalfEven, -2},
+		{big.NewRat(42, 1), money.RoundUp, 42},
+	}
+
+	for _, tc := range tcs {
+		r, err := Round(tc.r, "EUR", tc.mode)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %s with mode %d to be %d got %d %v", tc.r, tc.mode, tc.expected, r, err)
+		}
+	}
+}
+
+func TestRound2(t *testing.T) {
+	r := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 63))
+	if _, err := Round(r, "EUR", money.RoundDown); !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+}
@@ -0,0 +1,16 @@
+// Package calendar provides date arithmetic shared by the money packages.
+package calendar
+
+import "time"
+
+// AddMonths adds n months to t, clamping the day to the end of the resulting month,
+// e.g. one month after January 31 is the last day of February.
+func AddMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+
+	return first.AddDate(0, 0, d-1)
+}
@@ -0,0 +1,27 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddMonths(t *testing.T) {
+	tcs := []struct {
+		t        time.Time
+		n        int
+		expected time.Time
+	}{
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 2, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), -1, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 11, 15, 12, 30, 0, 0, time.UTC), 3, time.Date(2025, 2, 15, 12, 30, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 0, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tcs {
+		if r := AddMonths(tc.t, tc.n); !r.Equal(tc.expected) {
+			t.Errorf("Expected %d months after %s to be %s got %s", tc.n, tc.t, tc.expected, r)
+		}
+	}
+}
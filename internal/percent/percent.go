@@ -0,0 +1,36 @@
+// Package percent parses percentages shared by the money packages.
+package percent
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Parse parses decimal percentage such as "19", "12.5" or "7.5%" and returns its value, e.g. 12.5
+// of "12.5%". Fractions and exponents such as "1/3" or "1e5" are rejected.
+func Parse(s string) (*big.Rat, bool) {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	if !isDecimal(s) {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(s)
+}
+
+// isDecimal reports whether s is a signed decimal number with at least one digit, e.g. "-0.5".
+func isDecimal(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	digits, point := 0, false
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '.' && !point:
+			point = true
+		default:
+			return false
+		}
+	}
+
+	return digits > 0
+}
@@ -0,0 +1,34 @@
+package percent
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		s        string
+		expected *big.Rat
+	}{
+		{"19", big.NewRat(19, 1)},
+		{"12.5%", big.NewRat(25, 2)},
+		{" 7.5 % ", big.NewRat(15, 2)},
+		{"-3", big.NewRat(-3, 1)},
+		{".5", big.NewRat(1, 2)},
+	}
+
+	for _, tc := range tcs {
+		r, ok := Parse(tc.s)
+		if !ok || r.Cmp(tc.expected) != 0 {
+			t.Errorf("Expected %q to be %s got %v", tc.s, tc.expected, r)
+		}
+	}
+}
+
+func TestParse2(t *testing.T) {
+	for _, s := range []string{"", "%", "ten", ".", "1/3", "1e5", "0x10", "1.2.3", "--1", "1 000"} {
+		if r, ok := Parse(s); ok {
+			t.Errorf("Expected %q to be invalid got %s", s, r)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Package ledger implements a double-entry ledger of money.Money.
+//
+// Journal entries consist of debit and credit postings to accounts and must balance in every
+// currency. Balances are returned as money.Bag, so an account may hold multiple currencies.
+// Accounts and entries are kept by a Store, MemoryStore keeps them in memory.
+//
+// Trial balances and account statements are built from an Iterator over posted records
+// and can be rendered as CSV or plain text.
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+var (
+	// ErrUnknownAccount is returned when an account doesn't exist.
+	ErrUnknownAccount = errors.New("unknown account")
+	// ErrDuplicateAccount is returned when opening an account which already exists.
+	ErrDuplicateAccount = errors.New("account already exists")
+	// ErrInvalidAccount is returned when an account has no ID or an unknown type.
+	ErrInvalidAccount = errors.New("invalid account")
+	// ErrInvalidEntry is returned when an entry has less than two postings or a posting is invalid.
+	ErrInvalidEntry = errors.New("invalid entry")
+	// ErrUnbalanced is returned when debits and credits of an entry differ in a currency.
+	ErrUnbalanced = errors.New("entry doesn't balance")
+)
+
+// AccountType is the type of account, it determines the normal side of its balance.
+type AccountType int
+
+const (
+	// Asset accounts increase with debits.
+	Asset AccountType = iota
+	// Liability accounts increase with credits.
+	Liability
+	// Equity accounts increase with credits.
+	Equity
+	// Income accounts increase with credits.
+	Income
+	// Expense accounts increase with debits.
+	Expense
+)
+
+// Normal returns the side which increases balance of accounts of the type.
+func (t AccountType) Normal() Side {
+	if t == Asset || t == Expense {
+		return Debit
+	}
+
+	return Credit
+}
+
+func (t AccountType) valid() bool {
+	return t >= Asset && t <= Expense
+}
+
+// Account is an account of the ledger.
+type Account struct {
+	ID   string
+	Name string
+	Type AccountType
+}
+
+// Side is the side of a posting.
+type Side int
+
+const (
+	// Debit is the left side of an account.
+	Debit Side = iota
+	// Credit is the right side of an account.
+	Credit
+)
+
+func (s Side) String() string {
+	if s == Credit {
+		return "credit"
+	}
+
+	return "debit"
+}
+
+// Posting is a single debit or credit of an entry.
+type Posting struct {
+	Account string
+	Side    Side
+	// Amount is the posted amount, it must be positive.
+	Amount *money.Money
+}
+
+// Dr returns posting debiting given amount to account.
+func Dr(account string, amount *money.Money) Posting {
+	return Posting{Account: account, Side: Debit, Amount: amount}
+}
+
+// Cr returns posting crediting given amount to account.
+func Cr(account string, amount *money.Money) Posting {
+	return Posting{Account: account, Side: Credit, Amount: amount}
+}
+
+// Entry is a journal entry.
+type Entry struct {
+	ID          string
+	Date        time.Time
+	Description string
+	Postings    []Posting
+}
+
+// Validate checks that the entry has at least two positive postings and that debits equal credits
+// in every currency.
+func (e Entry) Validate() error {
+	if len(e.Postings) < 2 {
+		return fmt.Errorf("%w: %q has %d postings", ErrInvalidEntry, e.ID, len(e.Postings))
+	}
+
+	debits, credits := &money.Bag{}, &money.Bag{}
+	for i, p := range e.Postings {
+		if p.Account == "" || !p.Amount.IsPositive() || p.Side != Debit && p.Side != Credit {
+			return fmt.Errorf("%w: %q posting %d", ErrInvalidEntry, e.ID, i)
+		}
+
+		b := debits
+		if p.Side == Credit {
+			b = credits
+		}
+
+		if err := b.Add(p.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, code := range union(debits.Currencies(), credits.Currencies()) {
+		d, c := debits.Get(code), credits.Get(code)
+		if d.Amount() != c.Amount() {
+			return fmt.Errorf("%w: %q debits %s and credits %s", ErrUnbalanced, e.ID, d.Display(), c.Display())
+		}
+	}
+
+	return nil
+}
+
+// Ledger posts entries to accounts of a Store.
+type Ledger struct {
+	store Store
+}
+
+// New creates new Ledger keeping accounts and entries in given store.
+func New(s Store) *Ledger {
+	return &Ledger{store: s}
+}
+
+// Store returns the store of the ledger.
+func (l *Ledger) Store() Store {
+	return l.store
+}
+
+// Open opens new account.
+func (l *Ledger) Open(a Account) error {
+	if a.ID == "" || !a.Type.valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidAccount, a.ID)
+	}
+
+	return l.store.AddAccount(a)
+}
+
+// Post validates the entry and records it. All accounts of the entry must exist.
+func (l *Ledger) Post(e Entry) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	for _, p := range e.Postings {
+		if _, err := l.store.Account(p.Account); err != nil {
+			return err
+		}
+	}
+
+	return l.store.AddEntry(e)
+}
+
+// Balance returns balance of the account from entries dated at or before given time, or from all
+// entries when the time is zero. Balance is positive on the normal side of the account.
+func (l *Ledger) Balance(account string, at time.Time) (*money.Bag, error) {
+	a, err := l.store.Account(account)
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := l.store.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	b := &money.Bag{}
+	for _, e := range es {
+		if !at.IsZero() && e.Date.After(at) {
+			continue
+		}
+
+		for _, p := range e.Postings {
+			if p.Account != a.ID {
+				continue
+			}
+
+			if err := apply(b, a, p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return b, nil
+}
+
+// apply adds posting to balance of the account.
+func apply(b *money.Bag, a Account, p Posting) error {
+	if p.Side == a.Type.Normal() {
+		return b.Add(p.Amount)
+	}
+
+	return b.Subtract(p.Amount)
+}
+
+// union returns sorted distinct codes of both slices.
+func union(a, b []string) []string {
+	seen := make(map[string]bool)
+	var rs []string
+	for _, s := range append(append([]string{}, a...), b...) {
+		if !seen[s] {
+			seen[s] = true
+			rs = append(rs, s)
+		}
+	}
+
+	sort.Strings(rs)
+
+	return rs
+}
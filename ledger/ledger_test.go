@@ -0,0 +1,143 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newLedger(t *testing.T) *Ledger {
+	l := New(NewMemoryStore())
+	for _, a := range []Account{
+		{ID: "cash", Name: "Cash", Type: Asset},
+		{ID: "bank", Name: "Bank", Type: Asset},
+		{ID: "loan", Name: "Loan", Type: Liability},
+		{ID: "sales", Name: "Sales", Type: Income},
+		{ID: "rent", Name: "Rent", Type: Expense},
+	} {
+		if err := l.Open(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return l
+}
+
+func TestEntry_Validate(t *testing.T) {
+	eur := func(a int64) *money.Money { return money.New(a, "EUR") }
+	usd := func(a int64) *money.Money { return money.New(a, "USD") }
+
+	tcs := []struct {
+		postings []Posting
+		expected error
+	}{
+		{[]Posting{Dr("cash", eur(100)), Cr("sales", eur(100))}, nil},
+		{[]Posting{Dr("cash", eur(100)), Cr("sales", eur(60)), Cr("loan", eur(40))}, nil},
+		{[]Posting{Dr("cash", eur(100)), Dr("bank", usd(50)), Cr("sales", eur(100)), Cr("sales", usd(50))}, nil},
+		{[]Posting{Dr("cash", eur(100))}, ErrInvalidEntry},
+		{[]Posting{Dr("cash", eur(0)), Cr("sales", eur(0))}, ErrInvalidEntry},
+		{[]Posting{Dr("cash", eur(-100)), Cr("sales", eur(-100))}, ErrInvalidEntry},
+		{[]Posting{Dr("", eur(100)), Cr("sales", eur(100))}, ErrInvalidEntry},
+		{[]Posting{{Account: "cash", Side: 5, Amount: eur(100)}, Cr("sales", eur(100))}, ErrInvalidEntry},
+		{[]Posting{Dr("cash", eur(100)), Cr("sales", eur(99))}, ErrUnbalanced},
+		{[]Posting{Dr("cash", eur(100)), Cr("sales", usd(100))}, ErrUnbalanced},
+	}
+
+	for i, tc := range tcs {
+		err := Entry{ID: "e", Postings: tc.postings}.Validate()
+		if !errors.Is(err, tc.expected) || (tc.expected == nil) != (err == nil) {
+			t.Errorf("%d: expected %v got %v", i, tc.expected, err)
+		}
+	}
+}
+
+func TestLedger_Open(t *testing.T) {
+	l := newLedger(t)
+
+	tcs := []struct {
+		account  Account
+		expected error
+	}{
+		{Account{ID: "cash", Type: Asset}, ErrDuplicateAccount},
+		{Account{Type: Asset}, ErrInvalidAccount},
+		{Account{ID: "x", Type: 9}, ErrInvalidAccount},
+		{Account{ID: "equity", Type: Equity}, nil},
+	}
+
+	for _, tc := range tcs {
+		if err := l.Open(tc.account); !errors.Is(err, tc.expected) || (tc.expected == nil) != (err == nil) {
+			t.Errorf("Expected opening %q to return %v got %v", tc.account.ID, tc.expected, err)
+		}
+	}
+}
+
+func TestLedger_Post(t *testing.T) {
+	l := newLedger(t)
+
+	err := l.Post(Entry{ID: "1", Postings: []Posting{Dr("cash", money.New(100, "EUR")), Cr("nope", money.New(100, "EUR"))}})
+	if !errors.Is(err, ErrUnknownAccount) {
+		t.Errorf("Expected %v got %v", ErrUnknownAccount, err)
+	}
+
+	err = l.Post(Entry{ID: "2", Postings: []Posting{Dr("cash", money.New(100, "EUR")), Cr("sales", money.New(90, "EUR"))}})
+	if !errors.Is(err, ErrUnbalanced) {
+		t.Errorf("Expected %v got %v", ErrUnbalanced, err)
+	}
+
+	if es, _ := l.Store().Entries(); len(es) != 0 {
+		t.Errorf("Expected rejected entries not to be recorded got %d", len(es))
+	}
+}
+
+func TestLedger_Balance(t *testing.T) {
+	l := newLedger(t)
+
+	entries := []Entry{
+		{ID: "1", Date: day(1), Postings: []Posting{Dr("bank", money.New(100000, "EUR")), Cr("loan", money.New(100000, "EUR"))}},
+		{ID: "2", Date: day(5), Postings: []Posting{Dr("rent", money.New(80000, "EUR")), Cr("bank", money.New(80000, "EUR"))}},
+		{ID: "3", Date: day(10), Postings: []Posting{Dr("cash", money.New(2500, "USD")), Cr("sales", money.New(2500, "USD"))}},
+		{ID: "4", Date: day(3), Postings: []Posting{Dr("bank", money.New(5000, "EUR")), Cr("sales", money.New(5000, "EUR"))}},
+	}
+
+	for _, e := range entries {
+		if err := l.Post(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tcs := []struct {
+		account  string
+		at       time.Time
+		expected string
+	}{
+		{"bank", time.Time{}, `{"EUR":25000}`},
+		{"bank", day(4), `{"EUR":105000}`},
+		{"bank", day(1), `{"EUR":100000}`},
+		{"bank", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), `{}`},
+		{"loan", time.Time{}, `{"EUR":100000}`},
+		{"rent", day(31), `{"EUR":80000}`},
+		{"sales", time.Time{}, `{"EUR":5000,"USD":2500}`},
+		{"cash", day(9), `{}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := l.Balance(tc.account, tc.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r, _ := b.MarshalJSON(); string(r) != tc.expected {
+			t.Errorf("Expected %s balance at %s to be %s got %s", tc.account, tc.at, tc.expected, r)
+		}
+	}
+
+	if _, err := l.Balance("nope", time.Time{}); !errors.Is(err, ErrUnknownAccount) {
+		t.Errorf("Expected %v got %v", ErrUnknownAccount, err)
+	}
+}
@@ -0,0 +1,145 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// dateLayout is the layout of dates in rendered reports.
+const dateLayout = "2006-01-02"
+
+// WriteCSV writes the trial balance as CSV with account, currency, debit, credit and balance columns,
+// followed by the totals with empty account. Amounts are plain decimals, e.g. 1234.50.
+func (tb *TrialBalance) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"account", "currency", "debit", "credit", "balance"}); err != nil {
+		return err
+	}
+
+	for _, r := range append(append([]TrialBalanceRow{}, tb.Rows...), tb.Totals...) {
+		err := cw.Write([]string{r.Account, r.Debit.Currency().Code, decimal(r.Debit), decimal(r.Credit), decimal(r.Balance)})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteText writes the trial balance as aligned plain text with amounts formatted in their currency.
+func (tb *TrialBalance) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Account\tCurrency\tDebit\tCredit\tBalance\t")
+	for _, r := range tb.Rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t\n", r.Account, r.Debit.Currency().Code, r.Debit.Display(),
+			r.Credit.Display(), r.Balance.Display())
+	}
+
+	for _, r := range tb.Totals {
+		fmt.Fprintf(tw, "Total\t%s\t%s\t%s\t%s\t\n", r.Debit.Currency().Code, r.Debit.Display(),
+			r.Credit.Display(), r.Balance.Display())
+	}
+
+	return tw.Flush()
+}
+
+// WriteCSV writes the statement as CSV with date, entry, description, currency, debit, credit and
+// balance columns. Opening and closing balances of every currency are written as rows without entry
+// dated From and To. Amounts are plain decimals, e.g. 1234.50.
+func (s *Statement) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "entry", "description", "currency", "debit", "credit", "balance"}); err != nil {
+		return err
+	}
+
+	for _, m := range s.Opening.All() {
+		if err := cw.Write([]string{formatDate(s.From), "", "Opening balance", m.Currency().Code, "", "", decimal(m)}); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range s.Rows {
+		err := cw.Write([]string{formatDate(r.Date), r.Entry, r.Description, r.Balance.Currency().Code,
+			decimal(r.Debit), decimal(r.Credit), decimal(r.Balance)})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, m := range s.Closing.All() {
+		if err := cw.Write([]string{formatDate(s.To), "", "Closing balance", m.Currency().Code, "", "", decimal(m)}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteText writes the statement as aligned plain text with amounts formatted in their currency.
+func (s *Statement) WriteText(w io.Writer) error {
+	name := s.Account.ID
+	if s.Account.Name != "" {
+		name = fmt.Sprintf("%s (%s)", s.Account.Name, s.Account.ID)
+	}
+
+	title := "Statement of " + name
+	if !s.From.IsZero() {
+		title += " from " + formatDate(s.From)
+	}
+
+	if !s.To.IsZero() {
+		title += " to " + formatDate(s.To)
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\n\n", title); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Date\tEntry\tDescription\tDebit\tCredit\tBalance")
+	for _, m := range s.Opening.All() {
+		fmt.Fprintf(tw, "%s\t\tOpening balance\t\t\t%s\n", formatDate(s.From), m.Display())
+	}
+
+	for _, r := range s.Rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", formatDate(r.Date), r.Entry, r.Description,
+			blankZero(r.Debit), blankZero(r.Credit), r.Balance.Display())
+	}
+
+	for _, m := range s.Closing.All() {
+		fmt.Fprintf(tw, "%s\t\tClosing balance\t\t\t%s\n", formatDate(s.To), m.Display())
+	}
+
+	return tw.Flush()
+}
+
+// decimal formats m as plain decimal number without grapheme and thousand separators.
+func decimal(m *money.Money) string {
+	return money.NewFormatter(m.Currency().Fraction, ".", "", "", "1").Format(m.Amount())
+}
+
+// blankZero formats m in its currency, or returns empty string for zero.
+func blankZero(m *money.Money) string {
+	if m.IsZero() {
+		return ""
+	}
+
+	return m.Display()
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(dateLayout)
+}
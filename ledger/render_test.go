@@ -0,0 +1,91 @@
+package ledger
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestTrialBalance_Render(t *testing.T) {
+	it, _ := postedLedger(t).Records()
+	tb, err := NewTrialBalance(it, day(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := tb.WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `account,currency,debit,credit,balance
+bank,EUR,1050.00,0.00,1050.00
+loan,EUR,0.00,1000.00,-1000.00
+sales,EUR,0.00,50.00,-50.00
+,EUR,1050.00,1050.00,0.00
+`
+	if b.String() != expected {
+		t.Errorf("Expected %s got %s", expected, b.String())
+	}
+
+	b.Reset()
+	if err := tb.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = `  Account  Currency      Debit     Credit     Balance
+     bank       EUR  €1,050.00      €0.00   €1,050.00
+     loan       EUR      €0.00  €1,000.00  -€1,000.00
+    sales       EUR      €0.00     €50.00     -€50.00
+    Total       EUR  €1,050.00  €1,050.00       €0.00
+`
+	if b.String() != expected {
+		t.Errorf("Expected %s got %s", expected, b.String())
+	}
+}
+
+func TestStatement_Render(t *testing.T) {
+	l := postedLedger(t)
+	bank, _ := l.Store().Account("bank")
+	it, _ := l.Records()
+	s, err := NewStatement(it, bank, day(2), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := s.WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `date,entry,description,currency,debit,credit,balance
+2024-03-02,,Opening balance,EUR,,,1000.00
+2024-03-03,2,Invoice 7,EUR,50.00,0.00,1050.00
+2024-03-05,3,March rent,EUR,0.00,800.00,250.00
+2024-03-10,4,Card sale,USD,25.00,0.00,25.00
+,,Closing balance,EUR,,,250.00
+,,Closing balance,USD,,,25.00
+`
+	if b.String() != expected {
+		t.Errorf("Expected %s got %s", expected, b.String())
+	}
+
+	b.Reset()
+	if err := s.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = `Statement of Bank (bank) from 2024-03-02
+
+Date        Entry  Description      Debit   Credit   Balance
+2024-03-02         Opening balance                   €1,000.00
+2024-03-03  2      Invoice 7        €50.00           €1,050.00
+2024-03-05  3      March rent               €800.00  €250.00
+2024-03-10  4      Card sale        $25.00           $25.00
+                   Closing balance                   €250.00
+                   Closing balance                   $25.00
+`
+	if b.String() != expected {
+		t.Errorf("Expected %s got %s", expected, b.String())
+	}
+}
@@ -0,0 +1,240 @@
+package ledger
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// Record is a single posting together with its entry.
+type Record struct {
+	Entry       string
+	Date        time.Time
+	Description string
+	Posting
+}
+
+// check returns ErrInvalidEntry when the record has unknown side or invalid amount, which may
+// happen with records of custom iterators.
+func (r Record) check() error {
+	if r.Side != Debit && r.Side != Credit || !r.Amount.Valid() {
+		return fmt.Errorf("%w: %q posting to %q", ErrInvalidEntry, r.Entry, r.Account)
+	}
+
+	return nil
+}
+
+// Iterator iterates over records in date order.
+//
+//	for it.Next() {
+//		r := it.Record()
+//		...
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iterator interface {
+	// Next advances to the next record, it returns false when there are no more records or on error.
+	Next() bool
+	// Record returns the current record.
+	Record() Record
+	// Err returns the error which stopped the iteration, if any.
+	Err() error
+}
+
+type entryIterator struct {
+	entries []Entry
+	entry   int
+	posting int
+}
+
+// Records returns iterator over postings of given entries in order they are given.
+func Records(entries []Entry) Iterator {
+	return &entryIterator{entries: entries, posting: -1}
+}
+
+func (it *entryIterator) Next() bool {
+	it.posting++
+	for it.entry < len(it.entries) && it.posting >= len(it.entries[it.entry].Postings) {
+		it.entry++
+		it.posting = 0
+	}
+
+	return it.entry < len(it.entries)
+}
+
+func (it *entryIterator) Record() Record {
+	e := it.entries[it.entry]
+
+	return Record{Entry: e.ID, Date: e.Date, Description: e.Description, Posting: e.Postings[it.posting]}
+}
+
+func (it *entryIterator) Err() error {
+	return nil
+}
+
+// Records returns iterator over all postings of the ledger in date order.
+func (l *Ledger) Records() (Iterator, error) {
+	es, err := l.store.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	return Records(es), nil
+}
+
+// TrialBalanceRow holds debits and credits of an account in a single currency.
+// Balance is debits minus credits.
+type TrialBalanceRow struct {
+	Account string
+	Debit   *money.Money
+	Credit  *money.Money
+	Balance *money.Money
+}
+
+// TrialBalance lists debits and credits of every account per currency.
+type TrialBalance struct {
+	// At is the time of the trial balance, zero when it includes all records.
+	At time.Time
+	// Rows are ordered by account and currency code.
+	Rows []TrialBalanceRow
+	// Totals holds total of every currency ordered by currency code, with empty Account.
+	// Debits and credits of balanced entries are equal.
+	Totals []TrialBalanceRow
+}
+
+// NewTrialBalance builds trial balance from records dated at or before given time, or from all
+// records when the time is zero.
+func NewTrialBalance(it Iterator, at time.Time) (*TrialBalance, error) {
+	type key struct{ account, code string }
+	sums := make(map[key]*[2]int64)
+	var keys []key
+	for it.Next() {
+		r := it.Record()
+		if err := r.check(); err != nil {
+			return nil, err
+		}
+
+		if !at.IsZero() && r.Date.After(at) {
+			continue
+		}
+
+		for _, k := range []key{{r.Account, r.Amount.Currency().Code}, {"", r.Amount.Currency().Code}} {
+			if _, ok := sums[k]; !ok {
+				sums[k] = &[2]int64{}
+				keys = append(keys, k)
+			}
+
+			sums[k][r.Side] += r.Amount.Amount()
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].account != keys[j].account {
+			return keys[i].account < keys[j].account
+		}
+
+		return keys[i].code < keys[j].code
+	})
+
+	tb := &TrialBalance{At: at}
+	for _, k := range keys {
+		s := sums[k]
+		row := TrialBalanceRow{
+			Account: k.account,
+			Debit:   money.New(s[Debit], k.code),
+			Credit:  money.New(s[Credit], k.code),
+			Balance: money.New(s[Debit]-s[Credit], k.code),
+		}
+
+		if k.account == "" {
+			tb.Totals = append(tb.Totals, row)
+		} else {
+			tb.Rows = append(tb.Rows, row)
+		}
+	}
+
+	return tb, nil
+}
+
+// StatementRow is a single posting of an account statement.
+// Balance is the running balance of the account in the currency of the posting.
+type StatementRow struct {
+	Date        time.Time
+	Entry       string
+	Description string
+	Debit       *money.Money
+	Credit      *money.Money
+	Balance     *money.Money
+}
+
+// Statement lists postings of an account between two dates with running balance.
+// Balances are positive on the normal side of the account.
+type Statement struct {
+	Account Account
+	From    time.Time
+	To      time.Time
+	Opening *money.Bag
+	Closing *money.Bag
+	Rows    []StatementRow
+}
+
+// NewStatement builds statement of given account from records dated from from to to inclusive.
+// Records before from make up the opening balance. Zero from or to leave the period open.
+// Records must be in date order.
+func NewStatement(it Iterator, a Account, from, to time.Time) (*Statement, error) {
+	s := &Statement{Account: a, From: from, To: to, Opening: &money.Bag{}}
+	running := &money.Bag{}
+	for it.Next() {
+		r := it.Record()
+		if err := r.check(); err != nil {
+			return nil, err
+		}
+
+		if r.Account != a.ID || !to.IsZero() && r.Date.After(to) {
+			continue
+		}
+
+		if err := apply(running, a, r.Posting); err != nil {
+			return nil, err
+		}
+
+		if !from.IsZero() && r.Date.Before(from) {
+			if err := apply(s.Opening, a, r.Posting); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		zero := money.New(0, r.Amount.Currency().Code)
+		row := StatementRow{
+			Date:        r.Date,
+			Entry:       r.Entry,
+			Description: r.Description,
+			Debit:       zero,
+			Credit:      zero,
+			Balance:     running.Get(r.Amount.Currency().Code),
+		}
+
+		if r.Side == Debit {
+			row.Debit = r.Amount
+		} else {
+			row.Credit = r.Amount
+		}
+
+		s.Rows = append(s.Rows, row)
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	s.Closing = running
+
+	return s, nil
+}
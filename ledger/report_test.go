@@ -0,0 +1,202 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func postedLedger(t *testing.T) *Ledger {
+	l := newLedger(t)
+	for _, e := range []Entry{
+		{ID: "1", Date: day(1), Description: "Loan", Postings: []Posting{
+			Dr("bank", money.New(100000, "EUR")), Cr("loan", money.New(100000, "EUR"))}},
+		{ID: "2", Date: day(3), Description: "Invoice 7", Postings: []Posting{
+			Dr("bank", money.New(5000, "EUR")), Cr("sales", money.New(5000, "EUR"))}},
+		{ID: "3", Date: day(5), Description: "March rent", Postings: []Posting{
+			Dr("rent", money.New(80000, "EUR")), Cr("bank", money.New(80000, "EUR"))}},
+		{ID: "4", Date: day(10), Description: "Card sale", Postings: []Posting{
+			Dr("bank", money.New(2500, "USD")), Cr("sales", money.New(2500, "USD"))}},
+	} {
+		if err := l.Post(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return l
+}
+
+type failingIterator struct{}
+
+func (failingIterator) Next() bool     { return false }
+func (failingIterator) Record() Record { return Record{} }
+func (failingIterator) Err() error     { return errors.New("read failed") }
+
+type sliceIterator struct {
+	records []Record
+	current Record
+}
+
+func (it *sliceIterator) Next() bool {
+	if len(it.records) == 0 {
+		return false
+	}
+
+	it.current, it.records = it.records[0], it.records[1:]
+
+	return true
+}
+
+func (it *sliceIterator) Record() Record { return it.current }
+func (it *sliceIterator) Err() error     { return nil }
+
+func TestRecords(t *testing.T) {
+	it := Records([]Entry{
+		{ID: "1", Postings: []Posting{Dr("a", money.New(1, "EUR")), Cr("b", money.New(1, "EUR"))}},
+		{ID: "2"},
+		{ID: "3", Postings: []Posting{Dr("c", money.New(2, "EUR")), Cr("d", money.New(2, "EUR"))}},
+	})
+
+	var got string
+	for it.Next() {
+		r := it.Record()
+		got += r.Entry + r.Account
+	}
+
+	if got != "1a1b3c3d" || it.Err() != nil {
+		t.Errorf("Expected %s got %s", "1a1b3c3d", got)
+	}
+
+	if it := Records(nil); it.Next() {
+		t.Errorf("Expected no records")
+	}
+}
+
+func TestNewTrialBalance(t *testing.T) {
+	tcs := []struct {
+		at       time.Time
+		rows     []string
+		balances []int64
+		totals   []int64
+	}{
+		{time.Time{}, []string{"bank EUR", "bank USD", "loan EUR", "rent EUR", "sales EUR", "sales USD"},
+			[]int64{25000, 2500, -100000, 80000, -5000, -2500}, []int64{185000, 2500}},
+		{day(4), []string{"bank EUR", "loan EUR", "sales EUR"}, []int64{105000, -100000, -5000}, []int64{105000}},
+	}
+
+	for _, tc := range tcs {
+		it, _ := postedLedger(t).Records()
+		tb, err := NewTrialBalance(it, tc.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(tb.Rows) != len(tc.rows) || len(tb.Totals) != len(tc.totals) {
+			t.Fatalf("Expected %d rows and %d totals got %d and %d", len(tc.rows), len(tc.totals), len(tb.Rows),
+				len(tb.Totals))
+		}
+
+		for i, r := range tb.Rows {
+			name := r.Account + " " + r.Debit.Currency().Code
+			if name != tc.rows[i] || r.Balance.Amount() != tc.balances[i] ||
+				r.Debit.Amount()-r.Credit.Amount() != r.Balance.Amount() {
+				t.Errorf("Expected row %s with balance %d got %s with %d", tc.rows[i], tc.balances[i], name,
+					r.Balance.Amount())
+			}
+		}
+
+		for i, r := range tb.Totals {
+			if r.Debit.Amount() != tc.totals[i] || r.Credit.Amount() != tc.totals[i] || !r.Balance.IsZero() {
+				t.Errorf("Expected balanced total %d got %d and %d", tc.totals[i], r.Debit.Amount(), r.Credit.Amount())
+			}
+		}
+	}
+
+	if _, err := NewTrialBalance(failingIterator{}, time.Time{}); err == nil {
+		t.Errorf("Expected iterator error")
+	}
+}
+
+func TestNewTrialBalance2(t *testing.T) {
+	tcs := []Record{
+		{Entry: "1", Posting: Posting{Account: "bank", Side: Side(2), Amount: money.New(100, "EUR")}},
+		{Entry: "1", Posting: Posting{Account: "bank", Side: Side(-1), Amount: money.New(100, "EUR")}},
+		{Entry: "1", Posting: Posting{Account: "bank", Side: Debit}},
+	}
+
+	for i, r := range tcs {
+		if tb, err := NewTrialBalance(&sliceIterator{records: []Record{r}}, time.Time{}); tb != nil ||
+			!errors.Is(err, ErrInvalidEntry) {
+			t.Errorf("%d: expected %v got %v", i, ErrInvalidEntry, err)
+		}
+
+		a := Account{ID: "bank", Type: Asset}
+		if s, err := NewStatement(&sliceIterator{records: []Record{r}}, a, time.Time{}, time.Time{}); s != nil ||
+			!errors.Is(err, ErrInvalidEntry) {
+			t.Errorf("%d: expected %v got %v", i, ErrInvalidEntry, err)
+		}
+	}
+}
+
+func TestNewStatement(t *testing.T) {
+	l := postedLedger(t)
+	bank, _ := l.Store().Account("bank")
+	it, _ := l.Records()
+
+	s, err := NewStatement(it, bank, day(2), day(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b, _ := s.Opening.MarshalJSON(); string(b) != `{"EUR":100000}` {
+		t.Errorf("Expected opening %s got %s", `{"EUR":100000}`, b)
+	}
+
+	if b, _ := s.Closing.MarshalJSON(); string(b) != `{"EUR":25000}` {
+		t.Errorf("Expected closing %s got %s", `{"EUR":25000}`, b)
+	}
+
+	expected := []struct {
+		entry                  string
+		debit, credit, balance int64
+	}{
+		{"2", 5000, 0, 105000},
+		{"3", 0, 80000, 25000},
+	}
+
+	if len(s.Rows) != len(expected) {
+		t.Fatalf("Expected %d rows got %d", len(expected), len(s.Rows))
+	}
+
+	for i, r := range s.Rows {
+		e := expected[i]
+		if r.Entry != e.entry || r.Debit.Amount() != e.debit || r.Credit.Amount() != e.credit || r.Balance.Amount() != e.balance {
+			t.Errorf("Expected row %+v got %s %d %d %d", e, r.Entry, r.Debit.Amount(), r.Credit.Amount(), r.Balance.Amount())
+		}
+	}
+}
+
+func TestNewStatement_NormalSide(t *testing.T) {
+	l := postedLedger(t)
+	sales, _ := l.Store().Account("sales")
+	it, _ := l.Records()
+
+	s, err := NewStatement(it, sales, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b, _ := s.Closing.MarshalJSON(); string(b) != `{"EUR":5000,"USD":2500}` || len(s.Rows) != 2 || !s.Opening.IsZero() {
+		t.Errorf("Expected closing %s in %d rows got %s in %d", `{"EUR":5000,"USD":2500}`, 2, b, len(s.Rows))
+	}
+
+	if s.Rows[1].Balance.Amount() != 2500 || s.Rows[1].Balance.Currency().Code != "USD" {
+		t.Errorf("Expected running balance in USD got %s", s.Rows[1].Balance.Display())
+	}
+
+	if _, err := NewStatement(failingIterator{}, sales, time.Time{}, time.Time{}); err == nil {
+		t.Errorf("Expected iterator error")
+	}
+}
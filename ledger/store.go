@@ -0,0 +1,109 @@
+package ledger
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Store keeps accounts and entries of a Ledger. Ledger validates entries before adding them.
+type Store interface {
+	// AddAccount adds new account, it returns ErrDuplicateAccount if the account exists.
+	AddAccount(a Account) error
+	// Account returns account with given ID, it returns ErrUnknownAccount if there is none.
+	Account(id string) (Account, error)
+	// Accounts returns all accounts ordered by ID.
+	Accounts() ([]Account, error)
+	// AddEntry records the entry.
+	AddEntry(e Entry) error
+	// Entries returns all entries ordered by date, entries of the same date in order they were added.
+	Entries() ([]Entry, error)
+}
+
+// MemoryStore is a Store keeping accounts and entries in memory. It is safe for concurrent use.
+// The zero value is an empty MemoryStore ready to use.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	accounts map[string]Account
+	entries  []Entry
+}
+
+// NewMemoryStore creates new empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// AddAccount is implementation of Store.
+func (s *MemoryStore) AddAccount(a Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.accounts[a.ID]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateAccount, a.ID)
+	}
+
+	if s.accounts == nil {
+		s.accounts = make(map[string]Account)
+	}
+
+	s.accounts[a.ID] = a
+
+	return nil
+}
+
+// Account is implementation of Store.
+func (s *MemoryStore) Account(id string) (Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.accounts[id]
+	if !ok {
+		return Account{}, fmt.Errorf("%w: %q", ErrUnknownAccount, id)
+	}
+
+	return a, nil
+}
+
+// Accounts is implementation of Store.
+func (s *MemoryStore) Accounts() ([]Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	as := make([]Account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		as = append(as, a)
+	}
+
+	sort.Slice(as, func(i, j int) bool {
+		return as[i].ID < as[j].ID
+	})
+
+	return as, nil
+}
+
+// AddEntry is implementation of Store.
+func (s *MemoryStore) AddEntry(e Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e.Postings = append([]Posting(nil), e.Postings...)
+
+	// Keep entries ordered by date, after the entries of the same date.
+	i := sort.Search(len(s.entries), func(i int) bool {
+		return s.entries[i].Date.After(e.Date)
+	})
+
+	s.entries = append(s.entries, Entry{})
+	copy(s.entries[i+1:], s.entries[i:])
+	s.entries[i] = e
+
+	return nil
+}
+
+// Entries is implementation of Store.
+func (s *MemoryStore) Entries() ([]Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]Entry(nil), s.entries...), nil
+}
@@ -0,0 +1,99 @@
+package ledger
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestMemoryStore_Accounts(t *testing.T) {
+	var s MemoryStore
+	for _, id := range []string{"b", "c", "a"} {
+		if err := s.AddAccount(Account{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.AddAccount(Account{ID: "a"}); !errors.Is(err, ErrDuplicateAccount) {
+		t.Errorf("Expected %v got %v", ErrDuplicateAccount, err)
+	}
+
+	if _, err := s.Account("d"); !errors.Is(err, ErrUnknownAccount) {
+		t.Errorf("Expected %v got %v", ErrUnknownAccount, err)
+	}
+
+	as, _ := s.Accounts()
+	var ids string
+	for _, a := range as {
+		ids += a.ID
+	}
+
+	if ids != "abc" {
+		t.Errorf("Expected accounts %s got %s", "abc", ids)
+	}
+}
+
+func TestMemoryStore_Entries(t *testing.T) {
+	s := NewMemoryStore()
+	for _, e := range []Entry{
+		{ID: "1", Date: day(2)},
+		{ID: "2", Date: day(1)},
+		{ID: "3", Date: day(2)},
+		{ID: "4", Date: day(3)},
+		{ID: "5", Date: day(1)},
+	} {
+		if err := s.AddEntry(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	es, _ := s.Entries()
+	var ids string
+	for _, e := range es {
+		ids += e.ID
+	}
+
+	if ids != "25134" {
+		t.Errorf("Expected entries %s got %s", "25134", ids)
+	}
+}
+
+func TestMemoryStore_Copies(t *testing.T) {
+	s := NewMemoryStore()
+	ps := []Posting{Dr("a", money.New(1, "EUR")), Cr("b", money.New(1, "EUR"))}
+	if err := s.AddEntry(Entry{ID: "1", Postings: ps}); err != nil {
+		t.Fatal(err)
+	}
+
+	ps[0].Account = "x"
+	es, _ := s.Entries()
+	es[0].ID = "y"
+
+	if es, _ = s.Entries(); es[0].ID != "1" || es[0].Postings[0].Account != "a" {
+		t.Errorf("Expected store to keep its own copy got %+v", es[0])
+	}
+}
+
+func TestMemoryStore_Concurrent(t *testing.T) {
+	l := New(NewMemoryStore())
+	_ = l.Open(Account{ID: "cash", Type: Asset})
+	_ = l.Open(Account{ID: "sales", Type: Income})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = l.Post(Entry{Postings: []Posting{Dr("cash", money.New(1, "EUR")), Cr("sales", money.New(1, "EUR"))}})
+			_, _ = l.Balance("cash", day(1))
+		}()
+	}
+
+	wg.Wait()
+
+	if b, _ := l.Balance("cash", day(1)); b.Get("EUR").Amount() != 50 {
+		t.Errorf("Expected %d got %d", 50, b.Get("EUR").Amount())
+	}
+}
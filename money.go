@@ -3,7 +3,6 @@ package money
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
 )
 
@@ -19,12 +18,27 @@ var (
 )
 
 func defaultUnmarshalJSON(m *Money, b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		return nil
+	}
+
 	data := make(map[string]interface{})
 	err := json.Unmarshal(b, &data)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	amount, ok := data["AmountData"].(float64)
+	if !ok {
+		return fmt.Errorf("%w: AmountData must be a number", ErrInvalidJSON)
+	}
+
+	code, ok := data["CurrencyData"].(string)
+	if !ok {
+		return fmt.Errorf("%w: CurrencyData must be a string", ErrInvalidJSON)
 	}
-	ref := New(int64(data["AmountData"].(float64)), data["CurrencyData"].(string))
+
+	ref := New(int64(amount), code)
 	*m = *ref
 	return nil
 }
@@ -55,23 +69,53 @@ func New(amount int64, code string) *Money {
 }
 
 // CurrencyData returns the CurrencyData used by Money.
+// Zero value Money returns the default currency with an empty code.
 func (m *Money) Currency() *Currency {
-	return m.CurrencyData
+	return m.currency()
 }
 
 // AmountData returns a copy of the internal monetary Value as an int64.
 func (m *Money) Amount() int64 {
-	return m.AmountData.Val
+	return m.amount().Val
+}
+
+// Valid returns boolean of whether Money has both AmountData and CurrencyData set.
+// Zero value Money (e.g. an unset struct field) is not valid and is treated as zero in an unset currency.
+func (m *Money) Valid() bool {
+	return m != nil && m.AmountData != nil && m.CurrencyData != nil && m.CurrencyData.Code != ""
+}
+
+// amount returns AmountData, zero for zero value Money.
+func (m *Money) amount() *Amount {
+	if m == nil || m.AmountData == nil {
+		return &Amount{}
+	}
+
+	return m.AmountData
+}
+
+// currency returns CurrencyData, currency with empty code for zero value Money.
+func (m *Money) currency() *Currency {
+	if m == nil || m.CurrencyData == nil {
+		return newCurrency("").get()
+	}
+
+	return m.CurrencyData
 }
 
 // SameCurrencyData check if given Money is equals by CurrencyData.
 func (m *Money) SameCurrencyData(om *Money) bool {
-	return m.CurrencyData.equals(om.CurrencyData)
+	return m.currency().equals(om.currency())
+}
+
+// withAmount returns new Money struct in Self CurrencyData with given Value.
+func (m *Money) withAmount(a int64) *Money {
+	return &Money{AmountData: &Amount{a}, CurrencyData: m.currency()}
 }
 
 func (m *Money) assertSameCurrencyData(om *Money) error {
 	if !m.SameCurrencyData(om) {
-		return errors.New("currencies don't match")
+		return &CurrencyMismatchError{Left: m.currency().Code, Right: om.currency().Code}
 	}
 
 	return nil
@@ -79,9 +123,9 @@ func (m *Money) assertSameCurrencyData(om *Money) error {
 
 func (m *Money) compare(om *Money) int {
 	switch {
-	case m.AmountData.Val > om.AmountData.Val:
+	case m.Amount() > om.Amount():
 		return 1
-	case m.AmountData.Val < om.AmountData.Val:
+	case m.Amount() < om.Amount():
 		return -1
 	}
 
@@ -133,29 +177,105 @@ func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
 	return m.compare(om) <= 0, nil
 }
 
+// Compare returns -1, 0 or 1 when the Value of Money is less than, equal to or greater than the other.
+func (m *Money) Compare(om *Money) (int, error) {
+	if err := m.assertSameCurrencyData(om); err != nil {
+		return 0, err
+	}
+
+	return m.compare(om), nil
+}
+
+// Between checks whether the Value of Money is within lo and hi, inclusive.
+func (m *Money) Between(lo, hi *Money) (bool, error) {
+	if err := m.assertRange(lo, hi); err != nil {
+		return false, err
+	}
+
+	return m.compare(lo) >= 0 && m.compare(hi) <= 0, nil
+}
+
+// Clamp returns new Money struct with the Value of Money limited to lo and hi, inclusive.
+func (m *Money) Clamp(lo, hi *Money) (*Money, error) {
+	if err := m.assertRange(lo, hi); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case m.compare(lo) < 0:
+		return m.withAmount(lo.Amount()), nil
+	case m.compare(hi) > 0:
+		return m.withAmount(hi.Amount()), nil
+	}
+
+	return m.withAmount(m.Amount()), nil
+}
+
+// MinOf returns new Money struct with the lower Value of Self and Other Money.
+func (m *Money) MinOf(om *Money) (*Money, error) {
+	if err := m.assertSameCurrencyData(om); err != nil {
+		return nil, err
+	}
+
+	if om.compare(m) < 0 {
+		return m.withAmount(om.Amount()), nil
+	}
+
+	return m.withAmount(m.Amount()), nil
+}
+
+// MaxOf returns new Money struct with the higher Value of Self and Other Money.
+func (m *Money) MaxOf(om *Money) (*Money, error) {
+	if err := m.assertSameCurrencyData(om); err != nil {
+		return nil, err
+	}
+
+	if om.compare(m) > 0 {
+		return m.withAmount(om.Amount()), nil
+	}
+
+	return m.withAmount(m.Amount()), nil
+}
+
+func (m *Money) assertRange(lo, hi *Money) error {
+	if err := m.assertSameCurrencyData(lo); err != nil {
+		return err
+	}
+
+	if err := m.assertSameCurrencyData(hi); err != nil {
+		return err
+	}
+
+	if lo.compare(hi) > 0 {
+		return fmt.Errorf("%w: %s is higher than %s", ErrInvalidRange, lo.Display(), hi.Display())
+	}
+
+	return nil
+}
+
 // IsZero returns boolean of whether the Value of Money is equals to zero.
 func (m *Money) IsZero() bool {
-	return m.AmountData.Val == 0
+	return m.Amount() == 0
 }
 
 // IsPositive returns boolean of whether the Value of Money is positive.
 func (m *Money) IsPositive() bool {
-	return m.AmountData.Val > 0
+	return m.Amount() > 0
 }
 
 // IsNegative returns boolean of whether the Value of Money is negative.
 func (m *Money) IsNegative() bool {
-	return m.AmountData.Val < 0
+	return m.Amount() < 0
 }
 
 // Absolute returns new Money struct from given Money using absolute monetary Value.
 func (m *Money) Absolute() *Money {
-	return &Money{AmountData: mutate.calc.absolute(m.AmountData), CurrencyData: m.CurrencyData}
+	return &Money{AmountData: mutate.calc.absolute(m.amount()), CurrencyData: m.currency()}
 }
 
 // Negative returns new Money struct from given Money using negative monetary Value.
 func (m *Money) Negative() *Money {
-	return &Money{AmountData: mutate.calc.negative(m.AmountData), CurrencyData: m.CurrencyData}
+	return &Money{AmountData: mutate.calc.negative(m.amount()), CurrencyData: m.currency()}
 }
 
 // Add returns new Money struct with Value representing sum of Self and Other Money.
@@ -164,7 +284,7 @@ func (m *Money) Add(om *Money) (*Money, error) {
 		return nil, err
 	}
 
-	return &Money{AmountData: mutate.calc.add(m.AmountData, om.AmountData), CurrencyData: m.CurrencyData}, nil
+	return &Money{AmountData: mutate.calc.add(m.amount(), om.amount()), CurrencyData: m.currency()}, nil
 }
 
 // Subtract returns new Money struct with Value representing difference of Self and Other Money.
@@ -173,17 +293,40 @@ func (m *Money) Subtract(om *Money) (*Money, error) {
 		return nil, err
 	}
 
-	return &Money{AmountData: mutate.calc.subtract(m.AmountData, om.AmountData), CurrencyData: m.CurrencyData}, nil
+	return &Money{AmountData: mutate.calc.subtract(m.amount(), om.amount()), CurrencyData: m.currency()}, nil
 }
 
 // Multiply returns new Money struct with Value representing Self multiplied Value by multiplier.
 func (m *Money) Multiply(mul int64) *Money {
-	return &Money{AmountData: mutate.calc.multiply(m.AmountData, mul), CurrencyData: m.CurrencyData}
+	return &Money{AmountData: mutate.calc.multiply(m.amount(), mul), CurrencyData: m.currency()}
 }
 
 // Round returns new Money struct with Value rounded to nearest zero.
 func (m *Money) Round() *Money {
-	return &Money{AmountData: mutate.calc.round(m.AmountData, m.CurrencyData.Fraction), CurrencyData: m.CurrencyData}
+	return &Money{AmountData: mutate.calc.round(m.amount(), m.currency().Fraction), CurrencyData: m.currency()}
+}
+
+// RoundCash returns new Money struct with Value rounded to the cash increment of its CurrencyData
+// using given rounding mode. Currencies without a cash increment are left unchanged.
+// ErrOverflow is returned when the rounded Value doesn't fit into int64.
+func (m *Money) RoundCash(mode RoundingMode) (*Money, error) {
+	a, err := mutate.calc.roundIncrement(m.amount(), m.currency().cashIncrement(), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{AmountData: a, CurrencyData: m.currency()}, nil
+}
+
+// CashRoundingDifference returns the adjustment applied by RoundCash, i.e. the cash rounded Value
+// minus the original Value, as printed on point-of-sale receipts.
+func (m *Money) CashRoundingDifference(mode RoundingMode) (*Money, error) {
+	r, err := m.RoundCash(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{AmountData: mutate.calc.subtract(r.amount(), m.amount()), CurrencyData: m.currency()}, nil
 }
 
 // Split returns slice of Money structs with split Self Value in given number.
@@ -191,22 +334,28 @@ func (m *Money) Round() *Money {
 // This means that parties listed first will likely receive more pennies than ones that are listed later.
 func (m *Money) Split(n int) ([]*Money, error) {
 	if n <= 0 {
-		return nil, errors.New("split must be higher than zero")
+		return nil, ErrInvalidSplit
+	}
+
+	a := mutate.calc.divide(m.amount(), int64(n))
+	l := mutate.calc.modulus(m.amount(), int64(n)).Val
+	sub := int64(1)
+	if l < 0 {
+		sub = -sub
 	}
 
-	a := mutate.calc.divide(m.AmountData, int64(n))
 	ms := make([]*Money, n)
 
 	for i := 0; i < n; i++ {
-		ms[i] = &Money{AmountData: a, CurrencyData: m.CurrencyData}
-	}
+		party := &Amount{a.Val}
 
-	l := mutate.calc.modulus(m.AmountData, int64(n)).Val
+		// Add leftovers to the first parties.
+		if l != 0 {
+			party = mutate.calc.add(party, &Amount{sub})
+			l -= sub
+		}
 
-	// Add leftovers to the first parties.
-	for p := 0; l != 0; p++ {
-		ms[p].AmountData = mutate.calc.add(ms[p].AmountData, &Amount{1})
-		l--
+		ms[i] = &Money{AmountData: party, CurrencyData: m.currency()}
 	}
 
 	return ms, nil
@@ -214,55 +363,22 @@ func (m *Money) Split(n int) ([]*Money, error) {
 
 // Allocate returns slice of Money structs with split Self Value in given ratios.
 // It lets split money by given ratios without losing pennies and as Split operations distributes
-// leftover pennies amongst the parties with round-robin principle.
+// leftover pennies amongst the parties with round-robin principle. Negative ratios are rejected
+// with ErrInvalidRatio.
 func (m *Money) Allocate(rs ...int) ([]*Money, error) {
-	if len(rs) == 0 {
-		return nil, errors.New("no ratios specified")
-	}
-
-	// Calculate sum of ratios.
-	var sum int
-	for _, r := range rs {
-		sum += r
-	}
-
-	var total int64
-	ms := make([]*Money, 0, len(rs))
-	for _, r := range rs {
-		party := &Money{
-			AmountData:   mutate.calc.allocate(m.AmountData, r, sum),
-			CurrencyData: m.CurrencyData,
-		}
-
-		ms = append(ms, party)
-		total += party.AmountData.Val
-	}
-
-	// Calculate leftover Value and divide to first parties.
-	lo := m.AmountData.Val - total
-	sub := int64(1)
-	if lo < 0 {
-		sub = -sub
-	}
-
-	for p := 0; lo != 0; p++ {
-		ms[p].AmountData = mutate.calc.add(ms[p].AmountData, &Amount{sub})
-		lo -= sub
-	}
-
-	return ms, nil
+	return m.AllocateWith(RemainderRoundRobin, rs...)
 }
 
 // Display lets represent Money struct as string in given CurrencyData Value.
 func (m *Money) Display() string {
-	c := m.CurrencyData.get()
-	return c.Formatter().Format(m.AmountData.Val)
+	c := m.currency().get()
+	return c.Formatter().Format(m.Amount())
 }
 
 // AsMajorUnits lets represent Money struct as subunits (float64) in given CurrencyData Value
 func (m *Money) AsMajorUnits() float64 {
-	c := m.CurrencyData.get()
-	return c.Formatter().ToMajorUnits(m.AmountData.Val)
+	c := m.currency().get()
+	return c.Formatter().ToMajorUnits(m.Amount())
 }
 
 // UnmarshalJSON is implementation of json.Unmarshaller
@@ -3,7 +3,9 @@ package money
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -479,6 +481,11 @@ func TestMoney_Allocate2(t *testing.T) {
 	if r != nil || err == nil {
 		t.Error("Expected err")
 	}
+
+	r, err = m.Allocate(3, -1)
+	if r != nil || !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Expected err %v got %v", ErrInvalidRatio, err)
+	}
 }
 
 func TestMoney_Format(t *testing.T) {
@@ -685,3 +692,364 @@ func TestCustomUnmarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, m.Display())
 	}
 }
+
+func TestMoney_RoundCash(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		code       string
+		mode       RoundingMode
+		expected   int64
+	}{
+		{1002, "CHF", RoundHalfUp, 1000},
+		{1003, "CHF", RoundHalfUp, 1005},
+		{1007, "CHF", RoundHalfUp, 1005},
+		{1008, "CHF", RoundHalfUp, 1010},
+		{-1003, "CHF", RoundHalfUp, -1005},
+		{1001, "CHF", RoundUp, 1005},
+		{1049, "SEK", RoundHalfUp, 1000},
+		{1050, "SEK", RoundHalfUp, 1100},
+		{1050, "SEK", RoundHalfEven, 1000},
+		{1003, "CAD", RoundDown, 1000},
+		{1003, "EUR", RoundHalfUp, 1003},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, tc.code)
+		r, err := m.RoundCash(tc.mode)
+
+		if err != nil {
+			t.Fatalf("Expected cash rounded %d %s to be %d got %v", tc.AmountData, tc.code, tc.expected, err)
+		}
+
+		if r.AmountData.Val != tc.expected {
+			t.Errorf("Expected cash rounded %d %s to be %d got %d", tc.AmountData, tc.code, tc.expected, r.AmountData.Val)
+		}
+
+		if m.AmountData.Val != tc.AmountData {
+			t.Errorf("Expected original amount %d to be unchanged got %d", tc.AmountData, m.AmountData.Val)
+		}
+	}
+}
+
+func TestMoney_RoundCash2(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		mode       RoundingMode
+	}{
+		{math.MaxInt64, RoundUp},
+		{math.MinInt64, RoundHalfUp},
+	}
+
+	for _, tc := range tcs {
+		if _, err := New(tc.AmountData, "CHF").RoundCash(tc.mode); err != ErrOverflow {
+			t.Errorf("Expected %v got %v", ErrOverflow, err)
+		}
+
+		if _, err := New(tc.AmountData, "CHF").CashRoundingDifference(tc.mode); err != ErrOverflow {
+			t.Errorf("Expected %v got %v", ErrOverflow, err)
+		}
+	}
+}
+
+func TestCurrency_SetCashIncrement(t *testing.T) {
+	AddCurrency("CASHTEST", "C", "1 $", ".", ",", 2).SetCashIncrement(10)
+
+	r, err := New(1234, "CASHTEST").RoundCash(RoundHalfUp)
+	if err != nil || r.Amount() != 1230 {
+		t.Errorf("Expected 1230 got %v %v", r, err)
+	}
+}
+
+func TestMoney_CashRoundingDifference(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		code       string
+		expected   int64
+	}{
+		{1002, "CHF", -2},
+		{1003, "CHF", 2},
+		{1000, "CHF", 0},
+		{1075, "SEK", 25},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, tc.code)
+		r, err := m.CashRoundingDifference(RoundHalfUp)
+
+		if err != nil {
+			t.Fatalf("Expected cash rounding difference of %d %s to be %d got %v", tc.AmountData, tc.code,
+				tc.expected, err)
+		}
+
+		if r.AmountData.Val != tc.expected || r.Currency().Code != tc.code {
+			t.Errorf("Expected cash rounding difference of %d %s to be %d got %d %s", tc.AmountData, tc.code,
+				tc.expected, r.AmountData.Val, r.Currency().Code)
+		}
+	}
+}
+
+func TestMoney_Split3(t *testing.T) {
+	m := New(-100, "EUR")
+	split, err := m.Split(3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rs []int64
+	for _, party := range split {
+		rs = append(rs, party.AmountData.Val)
+	}
+
+	if !reflect.DeepEqual([]int64{-34, -33, -33}, rs) {
+		t.Errorf("Expected split of %d to be %v got %v", -100, []int64{-34, -33, -33}, rs)
+	}
+
+	split, _ = New(100, "EUR").Split(3)
+	if split[1].AmountData == split[2].AmountData {
+		t.Error("Expected parties not to share amounts")
+	}
+}
+
+func TestMoney_RoundImmutable(t *testing.T) {
+	m := New(-175, "EUR")
+	m.Round()
+
+	if m.AmountData.Val != -175 {
+		t.Errorf("Expected Round not to modify %d got %d", -175, m.AmountData.Val)
+	}
+}
+
+func TestMoney_Valid(t *testing.T) {
+	tcs := []*Money{New(100, "EUR"), New(0, "EUR"), New(-1, "JPY"), New(1, "XYZ")}
+
+	for _, m := range tcs {
+		if !m.Valid() {
+			t.Errorf("Expected %d %s to be valid", m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestMoney_ZeroValue(t *testing.T) {
+	var unset *Money
+	zeros := map[string]*Money{
+		"zero value":       {},
+		"nil pointer":      unset,
+		"missing amount":   {CurrencyData: New(0, "EUR").CurrencyData},
+		"missing currency": {AmountData: &Amount{0}},
+	}
+
+	for name, m := range zeros {
+		if m.Amount() != 0 || !m.IsZero() || m.IsPositive() || m.IsNegative() {
+			t.Errorf("%s: expected zero amount got %d", name, m.Amount())
+		}
+
+		if m.Currency() == nil {
+			t.Errorf("%s: expected currency", name)
+		}
+
+		if m.Valid() {
+			t.Errorf("%s: expected not to be valid", name)
+		}
+
+		cash, err := m.RoundCash(RoundHalfUp)
+		if err != nil {
+			t.Errorf("%s: expected no error got %v", name, err)
+		}
+
+		diff, err := m.CashRoundingDifference(RoundHalfUp)
+		if err != nil {
+			t.Errorf("%s: expected no error got %v", name, err)
+		}
+
+		for _, r := range []*Money{m.Absolute(), m.Negative(), m.Multiply(2), m.Round(), cash, diff} {
+			if r.Amount() != 0 || r.Currency().Code != m.Currency().Code {
+				t.Errorf("%s: expected zero result got %d %s", name, r.Amount(), r.Currency().Code)
+			}
+		}
+
+		if r, err := m.Add(m); err != nil || !r.IsZero() {
+			t.Errorf("%s: expected zero sum got %v, %v", name, r, err)
+		}
+
+		if r, err := m.Subtract(m); err != nil || !r.IsZero() {
+			t.Errorf("%s: expected zero difference got %v, %v", name, r, err)
+		}
+
+		if !m.SameCurrencyData(m) {
+			t.Errorf("%s: expected same currency", name)
+		}
+
+		for _, f := range []func(*Money) (bool, error){m.Equals, m.GreaterThanOrEqual, m.LessThanOrEqual} {
+			if r, err := f(m); err != nil || !r {
+				t.Errorf("%s: expected comparison to be true got %t, %v", name, r, err)
+			}
+		}
+
+		for _, f := range []func(*Money) (bool, error){m.GreaterThan, m.LessThan} {
+			if r, err := f(m); err != nil || r {
+				t.Errorf("%s: expected comparison to be false got %t, %v", name, r, err)
+			}
+		}
+
+		if name != "missing amount" {
+			if _, err := m.Add(New(0, "EUR")); err == nil {
+				t.Errorf("%s: expected err adding EUR to unset currency", name)
+			}
+		}
+
+		if ps, err := m.Split(2); err != nil || len(ps) != 2 || !ps[0].IsZero() {
+			t.Errorf("%s: expected zero split got %v, %v", name, ps, err)
+		}
+
+		if ps, err := m.Allocate(1, 2); err != nil || len(ps) != 2 || !ps[1].IsZero() {
+			t.Errorf("%s: expected zero allocation got %v, %v", name, ps, err)
+		}
+
+		if ps, err := m.AllocateByPercent("50", "50"); err != nil || len(ps) != 2 {
+			t.Errorf("%s: expected zero allocation got %v, %v", name, ps, err)
+		}
+
+		if ra, err := m.AllocateRules(RemainderRule("us")); err != nil || !ra.Parts[0].IsZero() {
+			t.Errorf("%s: expected zero rule allocation got %v, %v", name, ra, err)
+		}
+
+		if m.AsMajorUnits() != 0 {
+			t.Errorf("%s: expected zero major units got %f", name, m.AsMajorUnits())
+		}
+
+		if m.Display() == "" {
+			t.Errorf("%s: expected display", name)
+		}
+
+		if v := m.Value(); v.Amount() != 0 {
+			t.Errorf("%s: expected zero value got %s", name, v)
+		}
+	}
+}
+
+func TestMoney_ZeroValueDisplay(t *testing.T) {
+	var m Money
+
+	if m.Display() != "0.00" {
+		t.Errorf("Expected %s got %s", "0.00", m.Display())
+	}
+
+	if r, err := Sum(&m, &Money{}); err != nil || !r.IsZero() {
+		t.Errorf("Expected zero sum got %v, %v", r, err)
+	}
+
+	var b Bag
+	if err := b.Add(&m); err != nil || !b.IsZero() {
+		t.Errorf("Expected empty bag got %v, %v", b.All(), err)
+	}
+}
+
+func TestMoney_Compare(t *testing.T) {
+	m := New(0, "EUR")
+	tcs := []struct {
+		AmountData int64
+		expected   int
+	}{
+		{-1, 1},
+		{0, 0},
+		{1, -1},
+	}
+
+	for _, tc := range tcs {
+		om := New(tc.AmountData, "EUR")
+		r, err := m.Compare(om)
+
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d Compare %d == %d got %d", m.AmountData.Val,
+				om.AmountData.Val, tc.expected, r)
+		}
+	}
+
+	if _, err := m.Compare(New(0, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_Between(t *testing.T) {
+	lo := New(100, "EUR")
+	hi := New(200, "EUR")
+	tcs := []struct {
+		AmountData int64
+		expected   bool
+	}{
+		{99, false},
+		{100, true},
+		{150, true},
+		{200, true},
+		{201, false},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, "EUR")
+		r, err := m.Between(lo, hi)
+
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d Between %d and %d == %t got %t", tc.AmountData, lo.AmountData.Val,
+				hi.AmountData.Val, tc.expected, r)
+		}
+	}
+
+	if _, err := lo.Between(hi, lo); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Expected %v got %v", ErrInvalidRange, err)
+	}
+
+	if _, err := lo.Between(lo, New(200, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestMoney_Clamp(t *testing.T) {
+	lo := New(-100, "EUR")
+	hi := New(100, "EUR")
+	tcs := []struct {
+		AmountData int64
+		expected   int64
+	}{
+		{-150, -100},
+		{-100, -100},
+		{50, 50},
+		{150, 100},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.AmountData, "EUR")
+		r, err := m.Clamp(lo, hi)
+
+		if err != nil || r.AmountData.Val != tc.expected {
+			t.Errorf("Expected %d clamped to %d", tc.AmountData, tc.expected)
+		}
+	}
+
+	if r, err := lo.Clamp(hi, lo); r != nil || !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Expected %v got %v", ErrInvalidRange, err)
+	}
+}
+
+func TestMoney_MinOfMaxOf(t *testing.T) {
+	limit := New(50000, "EUR")
+	balance := New(65000, "EUR")
+
+	min, err := limit.MinOf(balance)
+	if err != nil || min.AmountData.Val != 50000 {
+		t.Errorf("Expected %d got %v, %v", 50000, min, err)
+	}
+
+	max, err := limit.MaxOf(balance)
+	if err != nil || max.AmountData.Val != 65000 {
+		t.Errorf("Expected %d got %v, %v", 65000, max, err)
+	}
+
+	if _, err := limit.MinOf(New(1, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := limit.MaxOf(New(1, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+}
@@ -0,0 +1,398 @@
+// Package moneycsv reads and writes money.Money columns of CSV files.
+//
+// Columns map a CSV amount column, and optionally a currency column, to Money. Amounts may be
+// integer minor units, decimals with configurable separators or amounts formatted with the currency
+// symbol. Rows which can't be parsed are reported with their line numbers and don't stop reading.
+package moneycsv
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	money "github.com/Sinojin/go-money"
+)
+
+var (
+	// ErrMissingColumn is returned when the header lacks a mapped column.
+	ErrMissingColumn = errors.New("missing column")
+	// ErrUnknownCurrency is returned for currency codes which are not known.
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// Format is the format of amounts in a column.
+type Format int
+
+const (
+	// MinorUnits amounts are integers in minor units, e.g. "123456".
+	MinorUnits Format = iota
+	// Decimal amounts are decimal numbers in major units, e.g. "1234.56" or "1.234,56".
+	Decimal
+	// Formatted amounts are formatted like Money.Display, e.g. "$1,234.56".
+	Formatted
+)
+
+// Column maps CSV columns to Money.
+type Column struct {
+	// Amount is the header of the amount column.
+	Amount string
+	// Currency is the header of the currency code column. Code is used for all rows when empty.
+	Currency string
+	// Code is the currency code of all rows when there is no currency column.
+	Code string
+	// Format of the amounts.
+	Format Format
+	// Decimal is the decimal separator of Decimal amounts, "." when empty.
+	Decimal string
+	// Thousand is the thousand separator of Decimal amounts, none when empty.
+	Thousand string
+}
+
+// formatter returns formatter for amounts of the column in given currency.
+func (c Column) formatter(cur *money.Currency) *money.Formatter {
+	if c.Format == Formatted {
+		return cur.Formatter()
+	}
+
+	dec := c.Decimal
+	if dec == "" {
+		dec = "."
+	}
+
+	return money.NewFormatter(cur.Fraction, dec, c.Thousand, "", "1")
+}
+
+// parse returns Money from amount and currency cells of the column.
+func (c Column) parse(amount, code string) (*money.Money, error) {
+	if c.Currency == "" {
+		code = c.Code
+	}
+
+	code = strings.ToUpper(strings.TrimSpace(code))
+	cur := money.GetCurrency(code)
+	if cur == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+
+	if c.Format == MinorUnits {
+		a, err := strconv.ParseInt(strings.TrimSpace(amount), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", money.ErrInvalidAmount, amount)
+		}
+
+		return money.New(a, code), nil
+	}
+
+	a, err := c.formatter(cur).Parse(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return money.New(a, code), nil
+}
+
+// format returns amount cell of m.
+func (c Column) format(m *money.Money) string {
+	if c.Format == MinorUnits {
+		return strconv.FormatInt(m.Amount(), 10)
+	}
+
+	return c.formatter(m.Currency()).Format(m.Amount())
+}
+
+// ParseError is a row level error.
+type ParseError struct {
+	// Line is the line of the row starting from 1.
+	Line int
+	// Column is the header of the column which failed, empty for errors of the whole row.
+	Column string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+
+	return fmt.Sprintf("line %d, column %q: %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors lists errors of all rows which couldn't be parsed.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, pe := range e {
+		msgs[i] = pe.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Row is a parsed CSV row.
+type Row struct {
+	// Line is the line of the row starting from 1.
+	Line int
+	// Record holds all cells of the row.
+	Record []string
+	// Amounts holds Money of every column in order of the columns.
+	Amounts []*money.Money
+}
+
+// Reader reads rows of CSV with a header row.
+type Reader struct {
+	// Comma is the field delimiter, ',' when zero.
+	Comma rune
+
+	lines   *lineReader
+	cr      *csv.Reader
+	columns []Column
+	index   [][2]int
+	header  []string
+}
+
+// NewReader returns Reader of Money in given columns.
+func NewReader(r io.Reader, columns ...Column) *Reader {
+	return &Reader{lines: &lineReader{r: bufio.NewReader(r)}, columns: columns}
+}
+
+// Header returns the header row, it reads it when needed.
+func (r *Reader) Header() ([]string, error) {
+	if r.header != nil {
+		return r.header, nil
+	}
+
+	h, line, err := r.record()
+	if err == io.EOF {
+		return nil, &ParseError{Line: line, Err: ErrMissingColumn}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	h = append([]string(nil), h...)
+
+	pos := make(map[string]int)
+	for i, name := range h {
+		pos[strings.TrimSpace(name)] = i
+	}
+
+	index := make([][2]int, len(r.columns))
+	for i, c := range r.columns {
+		index[i] = [2]int{-1, -1}
+		for j, name := range []string{c.Amount, c.Currency} {
+			if name == "" {
+				continue
+			}
+
+			p, ok := pos[name]
+			if !ok {
+				return nil, &ParseError{Line: line, Column: name, Err: ErrMissingColumn}
+			}
+
+			index[i][j] = p
+		}
+	}
+
+	r.header, r.index = h, index
+
+	return h, nil
+}
+
+// Read reads the next row, it returns io.EOF when there are no more rows. Rows which can't be
+// parsed return *ParseError and reading may continue with the next row, other errors are
+// returned as they are.
+func (r *Reader) Read() (*Row, error) {
+	if _, err := r.Header(); err != nil {
+		return nil, err
+	}
+
+	rec, line, err := r.record()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &Row{Line: line, Record: append([]string(nil), rec...)}
+	for i, c := range r.columns {
+		var cells [2]string
+		for j, p := range r.index[i] {
+			if p < 0 {
+				continue
+			}
+
+			if p >= len(rec) {
+				return nil, &ParseError{Line: line, Column: []string{c.Amount, c.Currency}[j], Err: ErrMissingColumn}
+			}
+
+			cells[j] = rec[p]
+		}
+
+		m, err := c.parse(cells[0], cells[1])
+		if err != nil {
+			return nil, &ParseError{Line: line, Column: c.Amount, Err: err}
+		}
+
+		row.Amounts = append(row.Amounts, m)
+	}
+
+	return row, nil
+}
+
+// record reads the next non empty record and returns it with its first line. Malformed records
+// return *ParseError, reading continues with the line following them.
+func (r *Reader) record() ([]string, int, error) {
+	if r.cr == nil {
+		r.cr = csv.NewReader(r.lines)
+		r.cr.FieldsPerRecord = -1
+		r.cr.ReuseRecord = true
+		if r.Comma != 0 {
+			r.cr.Comma = r.Comma
+		}
+	}
+
+	rec, err := r.cr.Read()
+	if pe, ok := err.(*csv.ParseError); ok {
+		return nil, pe.StartLine, &ParseError{Line: pe.StartLine, Err: pe.Err}
+	}
+
+	if err != nil {
+		return nil, r.lines.line + 1, err
+	}
+
+	// Quoted cells keep their line breaks, the record started that many lines before its last line.
+	line := r.lines.line
+	for _, cell := range rec {
+		line -= strings.Count(cell, "\n")
+	}
+
+	return rec, line, nil
+}
+
+// lineReader passes at most one line to each Read, so it has always read exactly up to the last
+// line read by csv.Reader and counts its lines.
+type lineReader struct {
+	r    *bufio.Reader
+	buf  []byte
+	line int
+}
+
+func (lr *lineReader) Read(p []byte) (int, error) {
+	if len(lr.buf) == 0 {
+		b, err := lr.r.ReadBytes('\n')
+		if len(b) == 0 {
+			return 0, err
+		}
+
+		lr.buf = b
+		lr.line++
+	}
+
+	n := copy(p, lr.buf)
+	lr.buf = lr.buf[n:]
+
+	return n, nil
+}
+
+// ReadAll reads all rows. Rows which can't be parsed are skipped and reported with ParseErrors
+// after reading all others.
+func (r *Reader) ReadAll() ([]*Row, error) {
+	if _, err := r.Header(); err != nil {
+		return nil, err
+	}
+
+	var rows []*Row
+	var errs ParseErrors
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			errs = append(errs, pe)
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(errs) > 0 {
+		return rows, errs
+	}
+
+	return rows, nil
+}
+
+// Writer writes rows of CSV with Money columns.
+type Writer struct {
+	// Comma is the field delimiter, ',' when zero.
+	Comma rune
+
+	w       *csv.Writer
+	columns []Column
+}
+
+// NewWriter returns Writer of Money in given columns.
+func NewWriter(w io.Writer, columns ...Column) *Writer {
+	return &Writer{w: csv.NewWriter(w), columns: columns}
+}
+
+// WriteHeader writes given header cells followed by headers of the amount and currency columns.
+func (w *Writer) WriteHeader(header ...string) error {
+	rec := append([]string{}, header...)
+	for _, c := range w.columns {
+		rec = append(rec, c.Amount)
+		if c.Currency != "" {
+			rec = append(rec, c.Currency)
+		}
+	}
+
+	return w.write(rec)
+}
+
+// Write writes given cells followed by amount and currency cells of Money of every column.
+func (w *Writer) Write(record []string, ms ...*money.Money) error {
+	if len(ms) != len(w.columns) {
+		return fmt.Errorf("%d amounts given for %d columns", len(ms), len(w.columns))
+	}
+
+	rec := append([]string{}, record...)
+	for i, c := range w.columns {
+		rec = append(rec, c.format(ms[i]))
+		if c.Currency != "" {
+			rec = append(rec, ms[i].Currency().Code)
+		}
+	}
+
+	return w.write(rec)
+}
+
+func (w *Writer) write(rec []string) error {
+	if w.Comma != 0 {
+		w.w.Comma = w.Comma
+	}
+
+	return w.w.Write(rec)
+}
+
+// Flush writes any buffered data and returns error of the writes, if any.
+func (w *Writer) Flush() error {
+	w.w.Flush()
+
+	return w.w.Error()
+}
@@ -0,0 +1,254 @@
+package moneycsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func amounts(rows []*Row) []string {
+	var rs []string
+	for _, r := range rows {
+		for _, m := range r.Amounts {
+			rs = append(rs, m.Display())
+		}
+	}
+
+	return rs
+}
+
+func TestReader_Formats(t *testing.T) {
+	tcs := []struct {
+		input    string
+		column   Column
+		comma    rune
+		expected []string
+	}{
+		{
+			"id,amount,currency\n1,123456,USD\n2,-5,eur\n3,1000,JPY\n",
+			Column{Amount: "amount", Currency: "currency"},
+			0,
+			[]string{"$1,234.56", "-€0.05", "¥1,000"},
+		},
+		{
+			"amount;currency\n1.234,56;EUR\n\"-7,5\";EUR\n",
+			Column{Amount: "amount", Currency: "currency", Format: Decimal, Decimal: ",", Thousand: "."},
+			';',
+			[]string{"€1,234.56", "-€7.50"},
+		},
+		{
+			"total\n12.5\n.99\n",
+			Column{Amount: "total", Code: "GBP", Format: Decimal},
+			0,
+			[]string{"£12.50", "£0.99"},
+		},
+		{
+			"amount,currency\n\"$1,234.56\",USD\n\"1,234.56 zł\",PLN\n",
+			Column{Amount: "amount", Currency: "currency", Format: Formatted},
+			0,
+			[]string{"$1,234.56", "1,234.56 zł"},
+		},
+	}
+
+	for _, tc := range tcs {
+		r := NewReader(strings.NewReader(tc.input), tc.column)
+		r.Comma = tc.comma
+		rows, err := r.ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(amounts(rows), tc.expected) {
+			t.Errorf("Expected %q to be read as %v got %v", tc.input, tc.expected, amounts(rows))
+		}
+	}
+}
+
+func TestReader_Columns(t *testing.T) {
+	input := "date,net,vat,currency\n2024-03-01,1000,190,EUR\n2024-03-02,500,35,EUR\n"
+	r := NewReader(strings.NewReader(input),
+		Column{Amount: "net", Currency: "currency"},
+		Column{Amount: "vat", Currency: "currency"},
+	)
+
+	row, err := r.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if row.Line != 2 || row.Record[0] != "2024-03-01" || row.Amounts[0].Amount() != 1000 || row.Amounts[1].Amount() != 190 {
+		t.Errorf("Expected line 2 with 1000 and 190 got %+v", row)
+	}
+
+	if h, _ := r.Header(); !reflect.DeepEqual(h, []string{"date", "net", "vat", "currency"}) {
+		t.Errorf("Expected header got %v", h)
+	}
+
+	if _, err := r.Read(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Expected %v got %v", io.EOF, err)
+	}
+}
+
+func TestReader_ParseErrors(t *testing.T) {
+	input := strings.Join([]string{
+		"id,amount,currency",
+		"1,100,EUR",
+		"2,abc,EUR",
+		"",
+		"3,100,XXX",
+		"\"4\nmultiline\",200,EUR",
+		"5",
+		"6,\"bad\"quote,EUR",
+		"7,300,EUR",
+	}, "\n")
+
+	rows, err := NewReader(strings.NewReader(input), Column{Amount: "amount", Currency: "currency"}).ReadAll()
+
+	var lines []int
+	for _, r := range rows {
+		lines = append(lines, r.Line)
+	}
+
+	if !reflect.DeepEqual(lines, []int{2, 6, 10}) {
+		t.Errorf("Expected rows on lines %v got %v", []int{2, 6, 10}, lines)
+	}
+
+	var errs ParseErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ParseErrors got %v", err)
+	}
+
+	expected := []struct {
+		line int
+		err  error
+	}{
+		{3, money.ErrInvalidAmount},
+		{5, ErrUnknownCurrency},
+		{8, ErrMissingColumn},
+		{9, csv.ErrQuote},
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors got %v", len(expected), errs)
+	}
+
+	for i, e := range expected {
+		if errs[i].Line != e.line || !errors.Is(errs[i], e.err) {
+			t.Errorf("Expected %v on line %d got %v", e.err, e.line, errs[i])
+		}
+	}
+
+	if !strings.Contains(err.Error(), `line 3, column "amount": invalid amount: "abc"`) {
+		t.Errorf("Expected error message got %s", err)
+	}
+}
+
+func TestReader_ParseErrors2(t *testing.T) {
+	input := "name,amount\n5\" screen,100\nb,200\nc,300\n"
+	rows, err := NewReader(strings.NewReader(input), Column{Amount: "amount", Code: "EUR"}).ReadAll()
+
+	var names []string
+	for _, r := range rows {
+		names = append(names, r.Record[0])
+	}
+
+	if !reflect.DeepEqual(names, []string{"b", "c"}) || rows[0].Line != 3 || rows[1].Line != 4 {
+		t.Errorf("Expected rows b and c on lines 3 and 4 got %v", names)
+	}
+
+	var errs ParseErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Line != 2 || !errors.Is(errs[0], csv.ErrBareQuote) {
+		t.Errorf("Expected bare quote on line 2 got %v", err)
+	}
+}
+
+func TestReader_MissingColumn(t *testing.T) {
+	tcs := []string{"id,total\n1,100\n", ""}
+	for _, input := range tcs {
+		_, err := NewReader(strings.NewReader(input), Column{Amount: "amount", Code: "EUR"}).ReadAll()
+		var pe *ParseError
+		if !errors.As(err, &pe) || !errors.Is(err, ErrMissingColumn) || pe.Line != 1 {
+			t.Errorf("Expected missing column on line 1 got %v", err)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b,
+		Column{Amount: "amount", Currency: "currency"},
+		Column{Amount: "decimal", Format: Decimal, Decimal: ",", Thousand: "."},
+		Column{Amount: "display", Format: Formatted},
+	)
+
+	if err := w.WriteHeader("id"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, m := range []*money.Money{money.New(123456, "EUR"), money.New(-5, "JPY")} {
+		if err := w.Write([]string{string(rune('a' + i))}, m, m, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Write(nil, money.New(1, "EUR")); err == nil {
+		t.Errorf("Expected error for missing amounts")
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "id,amount,currency,decimal,display\na,123456,EUR,\"1.234,56\",\"€1,234.56\"\nb,-5,JPY,-5,-¥5\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q got %q", expected, b.String())
+	}
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	cols := []Column{
+		{Amount: "minor", Currency: "currency"},
+		{Amount: "decimal", Currency: "currency", Format: Decimal},
+		{Amount: "display", Currency: "currency", Format: Formatted},
+	}
+
+	var b bytes.Buffer
+	w := NewWriter(&b, cols...)
+	w.Comma = '\t'
+	_ = w.WriteHeader()
+	ms := []*money.Money{money.New(123456789, "EUR"), money.New(-1, "BHD"), money.New(0, "PLN"), money.New(42, "JPY")}
+	for _, m := range ms {
+		if err := w.Write(nil, m, m, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewReader(&b, cols...)
+	r.Comma = '\t'
+	rows, err := r.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, row := range rows {
+		for _, m := range row.Amounts {
+			if ok, _ := m.Equals(ms[i]); !ok {
+				t.Errorf("Expected %s got %s", ms[i].Display(), m.Display())
+			}
+		}
+	}
+}
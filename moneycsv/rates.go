@@ -0,0 +1,99 @@
+package moneycsv
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// ErrInvalidRate is returned for exchange rates which aren't positive numbers.
+var ErrInvalidRate = errors.New("invalid rate")
+
+// rateColumns are the headers of exchange rate files.
+var rateColumns = []string{"from", "to", "rate"}
+
+// ReadRates reads exchange rates from CSV with from, to and rate columns, e.g. "USD,EUR,0.92".
+// Rates are decimals or fractions such as "1/3". Rows which can't be parsed are skipped and
+// reported with ParseErrors along with rates of all other rows.
+func ReadRates(r io.Reader) (*money.Rates, error) {
+	cr := NewReader(r)
+	h, err := cr.Header()
+	if err != nil {
+		return nil, err
+	}
+
+	pos := make(map[string]int)
+	for i, name := range h {
+		pos[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	index := make([]int, len(rateColumns))
+	for i, name := range rateColumns {
+		p, ok := pos[name]
+		if !ok {
+			return nil, &ParseError{Line: cr.lines.line, Column: name, Err: ErrMissingColumn}
+		}
+
+		index[i] = p
+	}
+
+	rates := money.NewRates()
+	var errs ParseErrors
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			errs = append(errs, pe)
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if pe := setRate(rates, row, index); pe != nil {
+			errs = append(errs, pe)
+		}
+	}
+
+	if len(errs) > 0 {
+		return rates, errs
+	}
+
+	return rates, nil
+}
+
+// setRate sets rate of the row, cells are at given indexes of from, to and rate columns.
+func setRate(rates *money.Rates, row *Row, index []int) *ParseError {
+	var cells [3]string
+	for i, p := range index {
+		if p >= len(row.Record) {
+			return &ParseError{Line: row.Line, Column: rateColumns[i], Err: ErrMissingColumn}
+		}
+
+		cells[i] = strings.TrimSpace(row.Record[p])
+	}
+
+	for i, code := range cells[:2] {
+		if money.GetCurrency(strings.ToUpper(code)) == nil {
+			return &ParseError{Line: row.Line, Column: rateColumns[i], Err: fmt.Errorf("%w: %q", ErrUnknownCurrency, code)}
+		}
+	}
+
+	rate, ok := new(big.Rat).SetString(cells[2])
+	if !ok || rate.Sign() <= 0 {
+		return &ParseError{Line: row.Line, Column: "rate", Err: fmt.Errorf("%w: %q", ErrInvalidRate, cells[2])}
+	}
+
+	rates.Set(cells[0], cells[1], rate)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package moneycsv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestReadRates(t *testing.T) {
+	input := strings.Join([]string{
+		"From,To,Rate",
+		"USD,EUR,0.92",
+		"gbp,usd,1/0.8",
+		"EUR,GBP,1/3",
+		"USD,XXX,2",
+		"USD,JPY,-1",
+		"USD,CHF",
+	}, "\n")
+
+	rates, err := ReadRates(strings.NewReader(input))
+
+	tcs := []struct {
+		amount   int64
+		from     string
+		to       string
+		expected int64
+	}{
+		{10000, "USD", "EUR", 9200},
+		{9200, "EUR", "USD", 10000},
+		{300, "EUR", "GBP", 100},
+	}
+
+	for _, tc := range tcs {
+		m, cerr := money.New(tc.amount, tc.from).Convert(tc.to, rates)
+		if cerr != nil || m.Amount() != tc.expected {
+			t.Errorf("Expected %d %s in %s to be %d got %v %v", tc.amount, tc.from, tc.to, tc.expected, m, cerr)
+		}
+	}
+
+	var errs ParseErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ParseErrors got %v", err)
+	}
+
+	expected := []struct {
+		line int
+		err  error
+	}{
+		{3, ErrInvalidRate},
+		{5, ErrUnknownCurrency},
+		{6, ErrInvalidRate},
+		{7, ErrMissingColumn},
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors got %v", len(expected), errs)
+	}
+
+	for i, e := range expected {
+		if errs[i].Line != e.line || !errors.Is(errs[i], e.err) {
+			t.Errorf("Expected %v on line %d got %v", e.err, e.line, errs[i])
+		}
+	}
+}
+
+func TestReadRates_MissingColumn(t *testing.T) {
+	_, err := ReadRates(strings.NewReader("from,to,value\nUSD,EUR,0.92\n"))
+	var pe *ParseError
+	if !errors.As(err, &pe) || !errors.Is(err, ErrMissingColumn) || pe.Column != "rate" {
+		t.Errorf("Expected missing rate column got %v", err)
+	}
+}
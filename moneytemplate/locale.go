@@ -0,0 +1,74 @@
+package moneytemplate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	money "github.com/Sinojin/go-money"
+)
+
+// locale holds separators and template of amounts in a locale. Template uses "1" for the number
+// and "$" for the grapheme like templates of money.Currency.
+type locale struct {
+	Decimal  string
+	Thousand string
+	Template string
+}
+
+// localesMu guards locales which AddLocale may change concurrently with formatting.
+var localesMu sync.RWMutex
+
+// locales are keyed by lower case language, optionally followed by "-" and region.
+var locales = map[string]locale{
+	"de":    {Decimal: ",", Thousand: ".", Template: "1 $"},
+	"de-ch": {Decimal: ".", Thousand: "\u2019", Template: "$ 1"},
+	"en":    {Decimal: ".", Thousand: ",", Template: "$1"},
+	"es":    {Decimal: ",", Thousand: ".", Template: "1 $"},
+	"fr":    {Decimal: ",", Thousand: "\u202f", Template: "1 $"},
+	"it":    {Decimal: ",", Thousand: ".", Template: "1 $"},
+	"ja":    {Decimal: ".", Thousand: ",", Template: "$1"},
+	"nl":    {Decimal: ",", Thousand: ".", Template: "$ 1"},
+	"pl":    {Decimal: ",", Thousand: "\u00a0", Template: "1 $"},
+	"pt":    {Decimal: ",", Thousand: "\u00a0", Template: "1 $"},
+	"pt-br": {Decimal: ",", Thousand: ".", Template: "$ 1"},
+	"sv":    {Decimal: ",", Thousand: "\u00a0", Template: "1 $"},
+	"zh":    {Decimal: ".", Thousand: ",", Template: "$1"},
+}
+
+// AddLocale adds or replaces formatting of amounts in given locale, e.g. "de-AT". Template uses "1"
+// for the number and "$" for the grapheme of the currency, e.g. "1 $". It is safe for concurrent use.
+func AddLocale(name, decimal, thousand, template string) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[normalize(name)] = locale{Decimal: decimal, Thousand: thousand, Template: template}
+}
+
+// lookup returns formatting of the locale, or of its language when the region isn't known.
+func lookup(name string) (locale, error) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	key := normalize(name)
+	if l, ok := locales[key]; ok {
+		return l, nil
+	}
+
+	if i := strings.Index(key, "-"); i > 0 {
+		if l, ok := locales[key[:i]]; ok {
+			return l, nil
+		}
+	}
+
+	return locale{}, fmt.Errorf("%w: %q", ErrUnknownLocale, name)
+}
+
+// formatter returns formatter of amounts in currency c in the locale.
+func (l locale) formatter(c *money.Currency) *money.Formatter {
+	return money.NewFormatter(c.Fraction, l.Decimal, l.Thousand, c.Grapheme, l.Template)
+}
+
+// normalize returns key of locale name, e.g. "de-de" of "de_DE".
+func normalize(name string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(name), "_", "-", -1))
+}
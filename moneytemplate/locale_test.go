@@ -0,0 +1,72 @@
+package moneytemplate
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestAddLocale(t *testing.T) {
+	AddLocale("EN_ie", ".", ",", "$1")
+	AddLocale("tlh", "'", "", "1$")
+
+	tcs := []struct {
+		locale   string
+		expected string
+	}{
+		{"en-IE", "€1,234.56"},
+		{"tlh-QO", "1234'56€"},
+	}
+
+	for _, tc := range tcs {
+		s, err := FormatIn(tc.locale, money.New(123456, "EUR"))
+		if err != nil || s != tc.expected {
+			t.Errorf("Expected %s to be %s got %s %v", tc.locale, tc.expected, s, err)
+		}
+	}
+}
+
+func TestAddLocale_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			AddLocale("de-LU", ",", ".", "1 $")
+			_, _ = FormatIn("de-LU", money.New(123456, "EUR"))
+		}()
+	}
+
+	wg.Wait()
+
+	if s, err := FormatIn("de-LU", money.New(123456, "EUR")); err != nil || s != "1.234,56 €" {
+		t.Errorf("Expected %s got %s %v", "1.234,56 €", s, err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tcs := []struct {
+		name     string
+		expected string
+	}{
+		{"de", "1 $"},
+		{" DE-de ", "1 $"},
+		{"pt_BR", "$ 1"},
+		{"pt-PT", "1 $"},
+	}
+
+	for _, tc := range tcs {
+		l, err := lookup(tc.name)
+		if err != nil || l.Template != tc.expected {
+			t.Errorf("Expected %q to have template %q got %q %v", tc.name, tc.expected, l.Template, err)
+		}
+	}
+
+	for _, name := range []string{"", "-de", "xx-DE"} {
+		if _, err := lookup(name); !errors.Is(err, ErrUnknownLocale) {
+			t.Errorf("Expected %q to be unknown got %v", name, err)
+		}
+	}
+}
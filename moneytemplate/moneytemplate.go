@@ -0,0 +1,223 @@
+// Package moneytemplate provides template functions formatting money.Money.
+//
+// FuncMap returns functions for text/template and HTMLFuncMap adds variants for html/template which
+// escape the output, keep amounts on a single line and wrap the grapheme, so symbols such as ".د.إ"
+// are isolated from surrounding text. Functions take *money.Money or money.Money, amounts are
+// usually piped into them:
+//
+//	{{ .Total | money }}               €1,234.56
+//	{{ .Total | moneyIn "de-DE" }}     1.234,56 €
+//	{{ .Total | moneyCode }}           1,234.56 EUR
+//	{{ .Total | moneyMajor }}          1234.56
+//	{{ sumMoney .Lines | money }}      €2,469.12
+//	{{ .Total | moneyHTML }}           <span class="money"><bdi class="money-symbol">€</bdi>1,234.56</span>
+package moneytemplate
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	money "github.com/Sinojin/go-money"
+)
+
+var (
+	// ErrInvalidMoney is returned for arguments which aren't valid Money, it is money.ErrInvalidMoney.
+	ErrInvalidMoney = money.ErrInvalidMoney
+	// ErrUnknownLocale is returned for locales which are not known.
+	ErrUnknownLocale = errors.New("unknown locale")
+)
+
+// FuncMap returns functions for text/template:
+//
+//	money       formats Money in its currency, like Display
+//	moneyIn     formats Money in its currency with separators and symbol position of a locale
+//	moneyCode   formats Money with the currency code instead of the grapheme
+//	moneyMajor  formats Money as plain decimal of major units
+//	sumMoney    sums Money, slices of Money are summed item by item
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"money":      Format,
+		"moneyIn":    FormatIn,
+		"moneyCode":  FormatCode,
+		"moneyMajor": FormatMajor,
+		"sumMoney":   Sum,
+	}
+}
+
+// HTMLFuncMap returns functions of FuncMap for html/template along with moneyHTML, moneyInHTML and
+// moneyCodeHTML variants returning safe HTML.
+func HTMLFuncMap() htmltemplate.FuncMap {
+	fm := htmltemplate.FuncMap{
+		"moneyHTML":     FormatHTML,
+		"moneyInHTML":   FormatInHTML,
+		"moneyCodeHTML": FormatCodeHTML,
+	}
+
+	for name, f := range FuncMap() {
+		fm[name] = f
+	}
+
+	return fm
+}
+
+// Format formats Money in its currency.
+func Format(v interface{}) (string, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	return m.Display(), nil
+}
+
+// FormatIn formats Money in its currency with separators and symbol position of given locale,
+// e.g. "de-DE" or "fr". Locales fall back to their language when the region isn't known.
+func FormatIn(locale string, v interface{}) (string, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	l, err := lookup(locale)
+	if err != nil {
+		return "", err
+	}
+
+	return l.formatter(m.Currency()).Format(m.Amount()), nil
+}
+
+// FormatCode formats Money in its currency followed by the currency code, e.g. "1,234.56 EUR".
+func FormatCode(v interface{}) (string, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	return codeFormatter(m.Currency()).Format(m.Amount()), nil
+}
+
+// FormatMajor formats Money as plain decimal number of major units, e.g. "1234.56".
+func FormatMajor(v interface{}) (string, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	return money.NewFormatter(m.Currency().Fraction, ".", "", "", "1").Format(m.Amount()), nil
+}
+
+// Sum returns sum of given Money, arguments may be Money or slices of Money in the same currency.
+func Sum(vs ...interface{}) (*money.Money, error) {
+	var ms []*money.Money
+	for _, v := range vs {
+		switch s := v.(type) {
+		case []*money.Money:
+			for _, m := range s {
+				if !m.Valid() {
+					return nil, ErrInvalidMoney
+				}
+			}
+
+			ms = append(ms, s...)
+		case []money.Money:
+			for i := range s {
+				if !s[i].Valid() {
+					return nil, ErrInvalidMoney
+				}
+
+				ms = append(ms, &s[i])
+			}
+		default:
+			m, err := toMoney(v)
+			if err != nil {
+				return nil, err
+			}
+
+			ms = append(ms, m)
+		}
+	}
+
+	if len(ms) == 0 {
+		return nil, money.ErrEmpty
+	}
+
+	return money.Sum(ms...)
+}
+
+// FormatHTML formats Money in its currency as safe HTML.
+func FormatHTML(v interface{}) (htmltemplate.HTML, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	return formatHTML(m.Currency().Formatter(), m), nil
+}
+
+// FormatInHTML formats Money in its currency and given locale as safe HTML.
+func FormatInHTML(locale string, v interface{}) (htmltemplate.HTML, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	l, err := lookup(locale)
+	if err != nil {
+		return "", err
+	}
+
+	return formatHTML(l.formatter(m.Currency()), m), nil
+}
+
+// FormatCodeHTML formats Money followed by the currency code as safe HTML.
+func FormatCodeHTML(v interface{}) (htmltemplate.HTML, error) {
+	m, err := toMoney(v)
+	if err != nil {
+		return "", err
+	}
+
+	return formatHTML(codeFormatter(m.Currency()), m), nil
+}
+
+// symbol is a placeholder of the grapheme which is never escaped.
+const symbol = "\x00"
+
+// formatHTML formats m using f as escaped HTML within a "money" span. Spaces are non-breaking and
+// the grapheme is wrapped in bdi element with "money-symbol" class.
+func formatHTML(f *money.Formatter, m *money.Money) htmltemplate.HTML {
+	grapheme := f.Grapheme
+	f.Grapheme = symbol
+	s := html.EscapeString(f.Format(m.Amount()))
+	s = strings.Replace(s, " ", "&nbsp;", -1)
+	s = strings.Replace(s, symbol, `<bdi class="money-symbol">`+html.EscapeString(grapheme)+`</bdi>`, 1)
+
+	return htmltemplate.HTML(`<span class="money">` + s + `</span>`)
+}
+
+// codeFormatter returns formatter of amounts in currency c followed by its code.
+func codeFormatter(c *money.Currency) *money.Formatter {
+	return money.NewFormatter(c.Fraction, c.Decimal, c.Thousand, c.Code, "1 $")
+}
+
+// toMoney returns Money of template argument.
+func toMoney(v interface{}) (*money.Money, error) {
+	var m *money.Money
+	switch t := v.(type) {
+	case *money.Money:
+		m = t
+	case money.Money:
+		m = &t
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrInvalidMoney, v)
+	}
+
+	if !m.Valid() {
+		return nil, ErrInvalidMoney
+	}
+
+	return m, nil
+}
@@ -0,0 +1,120 @@
+package moneytemplate
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"testing"
+	"text/template"
+
+	money "github.com/Sinojin/go-money"
+)
+
+type invoice struct {
+	Total  *money.Money
+	Tax    money.Money
+	Lines  []*money.Money
+	Values []money.Money
+}
+
+func newInvoice() invoice {
+	return invoice{
+		Total:  money.New(123456, "EUR"),
+		Tax:    *money.New(-2050, "EUR"),
+		Lines:  []*money.Money{money.New(100000, "EUR"), money.New(23456, "EUR")},
+		Values: []money.Money{*money.New(1, "EUR"), *money.New(2, "EUR")},
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	tcs := []struct {
+		tmpl     string
+		expected string
+	}{
+		{`{{ .Total | money }}`, "€1,234.56"},
+		{`{{ .Tax | money }}`, "-€20.50"},
+		{`{{ .Total | moneyIn "de-DE" }}`, "1.234,56 €"},
+		{`{{ .Total | moneyIn "de_AT" }}`, "1.234,56 €"},
+		{`{{ .Total | moneyIn "de-CH" }}`, "€ 1’234.56"},
+		{`{{ .Total | moneyIn "fr" }}`, "1\u202f234,56 €"},
+		{`{{ .Total | moneyIn "en-US" }}`, "€1,234.56"},
+		{`{{ .Tax | moneyIn "nl" }}`, "-€ 20,50"},
+		{`{{ .Total | moneyCode }}`, "1,234.56 EUR"},
+		{`{{ .Tax | moneyMajor }}`, "-20.50"},
+		{`{{ sumMoney .Lines | money }}`, "€1,234.56"},
+		{`{{ sumMoney .Total .Tax .Values | moneyMajor }}`, "1214.09"},
+	}
+
+	for _, tc := range tcs {
+		var b bytes.Buffer
+		err := template.Must(template.New("").Funcs(FuncMap()).Parse(tc.tmpl)).Execute(&b, newInvoice())
+		if err != nil || b.String() != tc.expected {
+			t.Errorf("Expected %s to be %q got %q %v", tc.tmpl, tc.expected, b.String(), err)
+		}
+	}
+}
+
+func TestHTMLFuncMap(t *testing.T) {
+	tcs := []struct {
+		tmpl     string
+		expected string
+	}{
+		{`{{ .Total | money }}`, "€1,234.56"},
+		{`{{ .Total | moneyHTML }}`, `<span class="money"><bdi class="money-symbol">€</bdi>1,234.56</span>`},
+		{`{{ .Tax | moneyInHTML "de" }}`, `<span class="money">-20,50&nbsp;<bdi class="money-symbol">€</bdi></span>`},
+		{`{{ .Total | moneyCodeHTML }}`, `<span class="money">1,234.56&nbsp;<bdi class="money-symbol">EUR</bdi></span>`},
+		{`<p title="{{ .Total | moneyIn "de" }}">`, `<p title="1.234,56 €">`},
+	}
+
+	for _, tc := range tcs {
+		var b bytes.Buffer
+		tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(HTMLFuncMap()).Parse(tc.tmpl))
+		if err := tmpl.Execute(&b, newInvoice()); err != nil || b.String() != tc.expected {
+			t.Errorf("Expected %s to be %q got %q %v", tc.tmpl, tc.expected, b.String(), err)
+		}
+	}
+}
+
+func TestFormatHTML_Escape(t *testing.T) {
+	tst := &money.Currency{Code: "TST", Grapheme: "<b>", Template: "1 $", Decimal: ".", Thousand: ",", Fraction: 2}
+
+	h, err := FormatHTML(&money.Money{AmountData: &money.Amount{Val: 100}, CurrencyData: tst})
+	expected := htmltemplate.HTML(`<span class="money">1.00&nbsp;<bdi class="money-symbol">&lt;b&gt;</bdi></span>`)
+	if err != nil || h != expected {
+		t.Errorf("Expected %s got %s %v", expected, h, err)
+	}
+
+	h, err = FormatHTML(money.New(123456, "AED"))
+	expected = htmltemplate.HTML(`<span class="money">1,234.56&nbsp;<bdi class="money-symbol">.د.إ</bdi></span>`)
+	if err != nil || h != expected {
+		t.Errorf("Expected %s got %s %v", expected, h, err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tcs := []struct {
+		f        func() error
+		expected error
+	}{
+		{func() error { _, err := Format(nil); return err }, ErrInvalidMoney},
+		{func() error { _, err := Format(123); return err }, ErrInvalidMoney},
+		{func() error { _, err := Format(&money.Money{}); return err }, money.ErrInvalidMoney},
+		{func() error { _, err := Format(&money.Money{}); return err }, ErrInvalidMoney},
+		{func() error { _, err := FormatIn("xx", money.New(1, "EUR")); return err }, ErrUnknownLocale},
+		{func() error { _, err := Sum(); return err }, money.ErrEmpty},
+		{func() error { _, err := Sum([]*money.Money{nil}); return err }, ErrInvalidMoney},
+		{func() error { _, err := Sum(money.New(1, "EUR"), money.New(1, "USD")); return err }, money.ErrCurrencyMismatch},
+	}
+
+	for i, tc := range tcs {
+		if err := tc.f(); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected case %d to fail with %v got %v", i, tc.expected, err)
+		}
+	}
+
+	var b bytes.Buffer
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(`{{ .Total | moneyIn "xx" }}`))
+	if err := tmpl.Execute(&b, newInvoice()); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("Expected template to fail with %v got %v", ErrUnknownLocale, err)
+	}
+}
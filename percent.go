@@ -0,0 +1,69 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Sinojin/go-money/internal/percent"
+)
+
+// Percent returns new Money struct with given percentage (e.g. "19", "12.5" or "7.5%") of the Value
+// rounded to minor units of the currency using given mode.
+func (m *Money) Percent(p string, mode RoundingMode) (*Money, error) {
+	r, err := parsePercent(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MultiplyRat(r.Quo(r, big.NewRat(100, 1)), mode)
+}
+
+// MultiplyRat returns new Money struct with Value multiplied by exact rational factor
+// rounded to minor units of the currency using given mode.
+func (m *Money) MultiplyRat(f *big.Rat, mode RoundingMode) (*Money, error) {
+	r := new(big.Rat).SetInt64(m.Amount())
+	return newFromRat(r.Mul(r, f), m.currency(), mode)
+}
+
+// RatioTo returns exact ratio of Self Value to the Other Value.
+func (m *Money) RatioTo(om *Money) (*big.Rat, error) {
+	if err := m.assertSameCurrencyData(om); err != nil {
+		return nil, err
+	}
+
+	if om.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	return big.NewRat(m.Amount(), om.Amount()), nil
+}
+
+// PercentageChange returns exact percentage change from Self Value to the Other Value,
+// e.g. 25 when the Value grows from €8.00 to €10.00. The change is relative to the absolute Self Value,
+// so growth is positive for negative Values too, e.g. 50 from -€1.00 to -€0.50.
+func (m *Money) PercentageChange(om *Money) (*big.Rat, error) {
+	if err := m.assertSameCurrencyData(om); err != nil {
+		return nil, err
+	}
+
+	if m.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	r := big.NewRat(om.Amount(), 1)
+	r.Sub(r, big.NewRat(m.Amount(), 1))
+	r.Quo(r, new(big.Rat).Abs(big.NewRat(m.Amount(), 1)))
+
+	return r.Mul(r, big.NewRat(100, 1)), nil
+}
+
+// parsePercent parses decimal percentage such as "33.333" or "12.5%". Fractions and exponents such
+// as "1/3" or "1e5" are rejected.
+func parsePercent(p string) (*big.Rat, error) {
+	r, ok := percent.Parse(p)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrInvalidPercentage, p)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,100 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestMoney_Percent(t *testing.T) {
+	tcs := []struct {
+		AmountData int64
+		code       string
+		percent    string
+		mode       RoundingMode
+		expected   int64
+	}{
+		{1000, "EUR", "19", RoundHalfUp, 190},
+		{999, "EUR", "19%", RoundHalfUp, 190},
+		{999, "EUR", "19", RoundDown, 189},
+		{1050, "EUR", "5", RoundHalfEven, 52},
+		{1050, "EUR", "5", RoundHalfUp, 53},
+		{-1050, "EUR", "5", RoundHalfUp, -53},
+		{12345, "JPY", "8", RoundHalfUp, 988},
+		{12345, "BHD", "12.5", RoundHalfUp, 1543},
+		{100, "EUR", "0.5", RoundCeiling, 1},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.AmountData, tc.code).Percent(tc.percent, tc.mode)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r.Amount() != tc.expected || r.Currency().Code != tc.code {
+			t.Errorf("Expected %s%% of %d %s to be %d got %d", tc.percent, tc.AmountData, tc.code,
+				tc.expected, r.Amount())
+		}
+	}
+
+	for _, p := range []string{"ten", "1/3", "1e5"} {
+		if _, err := New(100, "EUR").Percent(p, RoundHalfUp); !errors.Is(err, ErrInvalidPercentage) {
+			t.Errorf("Expected %q to return %v got %v", p, ErrInvalidPercentage, err)
+		}
+	}
+
+	if _, err := New(1<<62, "EUR").Percent("400", RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_MultiplyRat(t *testing.T) {
+	r, err := New(1000, "EUR").MultiplyRat(big.NewRat(1, 3), RoundHalfUp)
+
+	if err != nil || r.Amount() != 333 {
+		t.Errorf("Expected %d got %v, %v", 333, r, err)
+	}
+}
+
+func TestMoney_RatioTo(t *testing.T) {
+	r, err := New(250, "EUR").RatioTo(New(1000, "EUR"))
+
+	if err != nil || r.Cmp(big.NewRat(1, 4)) != 0 {
+		t.Errorf("Expected %s got %v, %v", big.NewRat(1, 4), r, err)
+	}
+
+	if _, err := New(250, "EUR").RatioTo(New(0, "EUR")); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+
+	if _, err := New(250, "EUR").RatioTo(New(100, "USD")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoney_PercentageChange(t *testing.T) {
+	tcs := []struct {
+		from, to int64
+		expected *big.Rat
+	}{
+		{800, 1000, big.NewRat(25, 1)},
+		{1000, 800, big.NewRat(-20, 1)},
+		{300, 400, big.NewRat(100, 3)},
+		{-100, -50, big.NewRat(50, 1)},
+		{-100, -150, big.NewRat(-50, 1)},
+		{-100, 100, big.NewRat(200, 1)},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.from, "EUR").PercentageChange(New(tc.to, "EUR"))
+
+		if err != nil || r.Cmp(tc.expected) != 0 {
+			t.Errorf("Expected change from %d to %d to be %s got %v, %v", tc.from, tc.to, tc.expected, r, err)
+		}
+	}
+
+	if _, err := New(0, "EUR").PercentageChange(New(100, "EUR")); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+}
@@ -0,0 +1,108 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RateProvider provides exchange rates between currencies.
+type RateProvider interface {
+	// Rate returns how many major units of currency to are worth one major unit of currency from.
+	Rate(from, to string) (*big.Rat, error)
+}
+
+// Rates is a simple in-memory RateProvider. The zero value is ready to use.
+// Inverse rates are derived automatically when only one direction is set.
+type Rates struct {
+	rates map[string]*big.Rat
+}
+
+// NewRates creates new empty Rates instance.
+func NewRates() *Rates {
+	return &Rates{}
+}
+
+// Set stores exchange rate for a single major unit of currency from in currency to.
+// ErrInvalidRate is returned when the rate isn't positive.
+func (r *Rates) Set(from, to string, rate *big.Rat) error {
+	if rate == nil || rate.Sign() <= 0 {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidRate, from, to)
+	}
+
+	if r.rates == nil {
+		r.rates = make(map[string]*big.Rat)
+	}
+
+	r.rates[rateKey(from, to)] = new(big.Rat).Set(rate)
+
+	return nil
+}
+
+// Rate is implementation of RateProvider.
+func (r *Rates) Rate(from, to string) (*big.Rat, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	if rate, ok := r.rates[rateKey(from, to)]; ok {
+		return new(big.Rat).Set(rate), nil
+	}
+
+	if rate, ok := r.rates[rateKey(to, from)]; ok && rate.Sign() != 0 {
+		return new(big.Rat).Inv(rate), nil
+	}
+
+	return nil, fmt.Errorf("%w from %s to %s", ErrNoRate, from, to)
+}
+
+func rateKey(from, to string) string {
+	return strings.ToUpper(from) + "/" + strings.ToUpper(to)
+}
+
+// Convert returns new Money struct with Self Value converted to currency with given code using
+// rates of given provider. The result is rounded half up to minor units of the target currency.
+func (m *Money) Convert(code string, rp RateProvider) (*Money, error) {
+	to := newCurrency(code).get()
+	exact, err := m.convertExact(to, rp)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFromRat(exact, to, RoundHalfUp)
+}
+
+// convertExact returns Self Value in minor units of given currency without rounding.
+func (m *Money) convertExact(to *Currency, rp RateProvider) (*big.Rat, error) {
+	rate, err := rp.Rate(m.currency().Code, to.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(big.Rat).SetInt64(m.Amount())
+	r.Mul(r, rate)
+	r.Mul(r, new(big.Rat).SetFrac(pow10(to.Fraction), pow10(m.currency().Fraction)))
+
+	return r, nil
+}
+
+// NewFromRat creates new Money with Value of given exact number of minor units, e.g. big.NewRat(1000, 3),
+// rounded using given mode.
+func NewFromRat(r *big.Rat, code string, mode RoundingMode) (*Money, error) {
+	return newFromRat(r, newCurrency(code).get(), mode)
+}
+
+// newFromRat creates new Money with Value of given minor units rounded using given mode.
+func newFromRat(r *big.Rat, c *Currency, mode RoundingMode) (*Money, error) {
+	a := mode.quo(r.Num(), r.Denom())
+	if !a.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return &Money{AmountData: &Amount{a.Int64()}, CurrencyData: c}, nil
+}
+
+func pow10(e int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(e)), nil)
+}
@@ -0,0 +1,112 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestRates_Rate(t *testing.T) {
+	rs := NewRates()
+	rs.Set("EUR", "USD", big.NewRat(11, 10))
+
+	tcs := []struct {
+		from, to string
+		expected *big.Rat
+	}{
+		{"EUR", "USD", big.NewRat(11, 10)},
+		{"usd", "eur", big.NewRat(10, 11)},
+		{"GBP", "GBP", big.NewRat(1, 1)},
+	}
+
+	for _, tc := range tcs {
+		r, err := rs.Rate(tc.from, tc.to)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r.Cmp(tc.expected) != 0 {
+			t.Errorf("Expected rate %s/%s to be %s got %s", tc.from, tc.to, tc.expected, r)
+		}
+	}
+
+	if _, err := rs.Rate("EUR", "GBP"); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestRates_Set(t *testing.T) {
+	rs := NewRates()
+	for _, rate := range []*big.Rat{nil, big.NewRat(0, 1), big.NewRat(-11, 10)} {
+		if err := rs.Set("EUR", "USD", rate); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("Expected %v got %v", ErrInvalidRate, err)
+		}
+	}
+
+	if _, err := rs.Rate("EUR", "USD"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	var rs Rates
+	rs.Set("EUR", "USD", big.NewRat(11, 10))
+	rs.Set("USD", "JPY", big.NewRat(15025, 100))
+	rs.Set("EUR", "BHD", big.NewRat(41, 100))
+
+	tcs := []struct {
+		AmountData int64
+		from, to   string
+		expected   int64
+	}{
+		{1000, "EUR", "USD", 1100},
+		{1000, "USD", "EUR", 909},
+		{1, "USD", "JPY", 2},
+		{150, "JPY", "USD", 100},
+		{1000, "EUR", "BHD", 4100},
+		{-1000, "USD", "EUR", -909},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.AmountData, tc.from).Convert(tc.to, &rs)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if r.Amount() != tc.expected || r.Currency().Code != tc.to {
+			t.Errorf("Expected %d %s converted to %s to be %d got %d %s", tc.AmountData, tc.from, tc.to,
+				tc.expected, r.Amount(), r.Currency().Code)
+		}
+	}
+
+	if r, err := New(1000, "EUR").Convert("GBP", &rs); r != nil || err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestNewFromRat(t *testing.T) {
+	tcs := []struct {
+		r        *big.Rat
+		mode     RoundingMode
+		expected int64
+	}{
+		{big.NewRat(1000, 3), RoundHalfUp, 333},
+		{big.NewRat(2000, 3), RoundHalfUp, 667},
+		{big.NewRat(2000, 3), RoundDown, 666},
+		{big.NewRat(-5, 2), RoundHalfEven, -2},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromRat(tc.r, "eur", tc.mode)
+
+		if err != nil || m.Amount() != tc.expected || m.Currency().Code != "EUR" {
+			t.Errorf("Expected %s to be %d EUR got %v, %v", tc.r, tc.expected, m, err)
+		}
+	}
+
+	if _, err := NewFromRat(new(big.Rat).SetFrac(pow10(20), big.NewInt(1)), "EUR", RoundHalfUp); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
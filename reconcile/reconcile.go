@@ -0,0 +1,283 @@
+// Package reconcile matches two lists of money.Money records, e.g. bank statement lines against
+// internal payments.
+//
+// Items are matched one-to-one first, exact amounts before amounts within tolerance. Items left over
+// are then matched one-to-many and many-to-one, smaller groups first. Every match reports the
+// difference between its sides and items which couldn't be matched are reported as unmatched.
+package reconcile
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+var (
+	// ErrInvalidItem is returned when an item has no amount.
+	ErrInvalidItem = errors.New("invalid item")
+	// ErrInvalidOptions is returned when options are negative.
+	ErrInvalidOptions = errors.New("invalid options")
+)
+
+// Item is a record to reconcile.
+type Item struct {
+	ID        string
+	Amount    *money.Money
+	Date      time.Time
+	Reference string
+}
+
+// Options control which items match.
+type Options struct {
+	// Tolerance is the highest absolute difference of a match in minor units.
+	Tolerance int64
+	// Window is the highest difference between dates of matched items, dates are ignored when zero.
+	Window time.Duration
+	// RequireReference only matches items with equal references, compared case-insensitively.
+	// Items without reference are then never matched. Otherwise equal references are preferred.
+	RequireReference bool
+	// MaxGroup is the highest number of items matched to a single item. Values below 2 only allow
+	// one-to-one matches. Groups are searched exhaustively, so keep it small.
+	MaxGroup int
+}
+
+// Match is a group of left items matched to a group of right items. One of the groups always
+// has a single item.
+type Match struct {
+	Left  []Item
+	Right []Item
+	// Difference is the total of right items minus the total of left items.
+	Difference *money.Money
+}
+
+// Result holds matches in order of their first left item followed by the unmatched items
+// in order they were given.
+type Result struct {
+	Matches        []Match
+	UnmatchedLeft  []Item
+	UnmatchedRight []Item
+}
+
+// Reconcile matches left items against right items.
+func Reconcile(left, right []Item, o Options) (*Result, error) {
+	if o.Tolerance < 0 || o.Window < 0 || o.MaxGroup < 0 {
+		return nil, ErrInvalidOptions
+	}
+
+	for _, is := range [][]Item{left, right} {
+		for _, it := range is {
+			if !it.Amount.Valid() {
+				return nil, fmt.Errorf("%w: %q has no amount", ErrInvalidItem, it.ID)
+			}
+		}
+	}
+
+	r := &reconciler{
+		opts:  o,
+		left:  &side{items: left, used: make([]bool, len(left))},
+		right: &side{items: right, used: make([]bool, len(right))},
+	}
+
+	for _, tol := range []int64{0, o.Tolerance} {
+		for i, it := range left {
+			if r.left.used[i] {
+				continue
+			}
+
+			if j := r.best(it, tol); j >= 0 {
+				r.match([]int{i}, []int{j})
+			}
+		}
+	}
+
+	for k := 2; k <= o.MaxGroup; k++ {
+		for i, it := range left {
+			if r.left.used[i] {
+				continue
+			}
+
+			if g := r.group(it, r.right, k); g != nil {
+				r.match([]int{i}, g)
+			}
+		}
+
+		for j, it := range right {
+			if r.right.used[j] {
+				continue
+			}
+
+			if g := r.group(it, r.left, k); g != nil {
+				r.match(g, []int{j})
+			}
+		}
+	}
+
+	sort.SliceStable(r.matches, func(i, j int) bool {
+		return r.matches[i].first < r.matches[j].first
+	})
+
+	res := &Result{
+		UnmatchedLeft:  r.left.unused(),
+		UnmatchedRight: r.right.unused(),
+	}
+
+	for _, m := range r.matches {
+		res.Matches = append(res.Matches, m.Match)
+	}
+
+	return res, nil
+}
+
+type side struct {
+	items []Item
+	used  []bool
+}
+
+func (s *side) unused() []Item {
+	var is []Item
+	for i, it := range s.items {
+		if !s.used[i] {
+			is = append(is, it)
+		}
+	}
+
+	return is
+}
+
+type indexedMatch struct {
+	Match
+	first int
+}
+
+type reconciler struct {
+	opts    Options
+	left    *side
+	right   *side
+	matches []indexedMatch
+}
+
+// best returns index of the unused right item closest to it within tolerance, or -1.
+// Candidates are ranked by difference, equal reference, date distance and order.
+func (r *reconciler) best(it Item, tol int64) int {
+	best := -1
+	var bestKey [3]int64
+	for j, c := range r.right.items {
+		if r.right.used[j] || !r.compatible(it, c) {
+			continue
+		}
+
+		diff := abs(c.Amount.Amount() - it.Amount.Amount())
+		if diff > tol {
+			continue
+		}
+
+		var ref int64
+		if !sameReference(it, c) {
+			ref = 1
+		}
+
+		key := [3]int64{diff, ref, abs(int64(c.Date.Sub(it.Date)))}
+		if best < 0 || less(key, bestKey) {
+			best, bestKey = j, key
+		}
+	}
+
+	return best
+}
+
+// group returns indexes of k unused items of s whose total is closest to amount of it within tolerance,
+// or nil. Among equally close groups the one with the earliest items is returned.
+func (r *reconciler) group(it Item, s *side, k int) []int {
+	var cs []int
+	for j, c := range s.items {
+		if !s.used[j] && r.compatible(it, c) {
+			cs = append(cs, j)
+		}
+	}
+
+	var best []int
+	var bestDiff int64
+	g := make([]int, 0, k)
+	var search func(from int, sum int64)
+	search = func(from int, sum int64) {
+		if len(g) == k {
+			diff := abs(sum - it.Amount.Amount())
+			if diff <= r.opts.Tolerance && (best == nil || diff < bestDiff) {
+				best, bestDiff = append([]int(nil), g...), diff
+			}
+
+			return
+		}
+
+		for n := from; n <= len(cs)-(k-len(g)); n++ {
+			g = append(g, cs[n])
+			search(n+1, sum+s.items[cs[n]].Amount.Amount())
+			g = g[:len(g)-1]
+		}
+	}
+
+	search(0, 0)
+
+	return best
+}
+
+// compatible reports whether items may be matched by currency, date window and reference.
+func (r *reconciler) compatible(a, b Item) bool {
+	if !a.Amount.SameCurrencyData(b.Amount) {
+		return false
+	}
+
+	if r.opts.Window > 0 && abs(int64(a.Date.Sub(b.Date))) > int64(r.opts.Window) {
+		return false
+	}
+
+	return !r.opts.RequireReference || sameReference(a, b)
+}
+
+func (r *reconciler) match(ls, rs []int) {
+	m := indexedMatch{first: ls[0]}
+	var diff int64
+	for _, i := range ls {
+		r.left.used[i] = true
+		m.Left = append(m.Left, r.left.items[i])
+		diff -= r.left.items[i].Amount.Amount()
+	}
+
+	for _, j := range rs {
+		r.right.used[j] = true
+		m.Right = append(m.Right, r.right.items[j])
+		diff += r.right.items[j].Amount.Amount()
+	}
+
+	m.Difference = money.New(diff, m.Left[0].Amount.Currency().Code)
+	r.matches = append(r.matches, m)
+}
+
+// sameReference reports whether items have equal references, blank references are never equal.
+func sameReference(a, b Item) bool {
+	ra, rb := strings.TrimSpace(a.Reference), strings.TrimSpace(b.Reference)
+
+	return ra != "" && strings.EqualFold(ra, rb)
+}
+
+func less(a, b [3]int64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+
+	return false
+}
+
+func abs(a int64) int64 {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
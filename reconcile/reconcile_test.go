@@ -0,0 +1,242 @@
+package reconcile
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestReconcile(t *testing.T) {
+	mar1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	mar5 := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	mar9 := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	mar10 := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	mar12 := time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC)
+	mar20 := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	tcs := []struct {
+		name      string
+		left      []Item
+		right     []Item
+		opts      Options
+		matches   []string
+		unmatched [2][]string
+	}{
+		{
+			"exact",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR")}, {ID: "b", Amount: money.New(2000, "EUR")}},
+			[]Item{
+				{ID: "1", Amount: money.New(2000, "EUR")},
+				{ID: "2", Amount: money.New(1000, "EUR")},
+				{ID: "3", Amount: money.New(500, "EUR")},
+			},
+			Options{},
+			[]string{"a=2:€0.00", "b=1:€0.00"},
+			[2][]string{nil, {"3"}},
+		},
+		{
+			"exact before tolerance",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR")}, {ID: "b", Amount: money.New(1001, "EUR")}},
+			[]Item{{ID: "1", Amount: money.New(1001, "EUR")}, {ID: "2", Amount: money.New(999, "EUR")}},
+			Options{Tolerance: 2},
+			[]string{"a=2:-€0.01", "b=1:€0.00"},
+			[2][]string{},
+		},
+		{
+			"outside tolerance",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR")}},
+			[]Item{{ID: "1", Amount: money.New(1005, "EUR")}},
+			Options{Tolerance: 4},
+			nil,
+			[2][]string{{"a"}, {"1"}},
+		},
+		{
+			"date window",
+			[]Item{
+				{ID: "a", Amount: money.New(1000, "EUR"), Date: mar1},
+				{ID: "b", Amount: money.New(1000, "EUR"), Date: mar10},
+			},
+			[]Item{
+				{ID: "1", Amount: money.New(1000, "EUR"), Date: mar12},
+				{ID: "2", Amount: money.New(1000, "EUR"), Date: mar20},
+			},
+			Options{Window: 3 * 24 * time.Hour},
+			[]string{"b=1:€0.00"},
+			[2][]string{{"a"}, {"2"}},
+		},
+		{
+			"closest date",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR"), Date: mar10}},
+			[]Item{
+				{ID: "1", Amount: money.New(1000, "EUR"), Date: mar1},
+				{ID: "2", Amount: money.New(1000, "EUR"), Date: mar9},
+				{ID: "3", Amount: money.New(1000, "EUR"), Date: mar12},
+			},
+			Options{},
+			[]string{"a=2:€0.00"},
+			[2][]string{nil, {"1", "3"}},
+		},
+		{
+			"preferred reference",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR"), Date: mar1, Reference: "INV-7"}},
+			[]Item{
+				{ID: "1", Amount: money.New(1000, "EUR"), Date: mar1, Reference: "INV-6"},
+				{ID: "2", Amount: money.New(1000, "EUR"), Date: mar5, Reference: " inv-7"},
+			},
+			Options{},
+			[]string{"a=2:€0.00"},
+			[2][]string{nil, {"1"}},
+		},
+		{
+			"required reference",
+			[]Item{
+				{ID: "a", Amount: money.New(1000, "EUR"), Reference: "INV-7"},
+				{ID: "b", Amount: money.New(500, "EUR"), Reference: "INV-8"},
+			},
+			[]Item{
+				{ID: "1", Amount: money.New(1000, "EUR"), Reference: "INV-6"},
+				{ID: "2", Amount: money.New(500, "EUR"), Reference: "INV-8"},
+			},
+			Options{RequireReference: true},
+			[]string{"b=2:€0.00"},
+			[2][]string{{"a"}, {"1"}},
+		},
+		{
+			"required blank reference",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR")}},
+			[]Item{{ID: "1", Amount: money.New(1000, "EUR"), Reference: " "}},
+			Options{RequireReference: true},
+			nil,
+			[2][]string{{"a"}, {"1"}},
+		},
+		{
+			"one to many",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR")}, {ID: "b", Amount: money.New(300, "EUR")}},
+			[]Item{
+				{ID: "1", Amount: money.New(600, "EUR")},
+				{ID: "2", Amount: money.New(250, "EUR")},
+				{ID: "3", Amount: money.New(400, "EUR")},
+				{ID: "4", Amount: money.New(300, "EUR")},
+			},
+			Options{MaxGroup: 3},
+			[]string{"a=13:€0.00", "b=4:€0.00"},
+			[2][]string{nil, {"2"}},
+		},
+		{
+			"many to one",
+			[]Item{
+				{ID: "a", Amount: money.New(700, "EUR")},
+				{ID: "b", Amount: money.New(250, "EUR")},
+				{ID: "c", Amount: money.New(49, "EUR")},
+			},
+			[]Item{{ID: "1", Amount: money.New(1000, "EUR")}},
+			Options{MaxGroup: 3, Tolerance: 1},
+			[]string{"abc=1:€0.01"},
+			[2][]string{},
+		},
+		{
+			"groups disabled",
+			[]Item{{ID: "a", Amount: money.New(1000, "EUR")}},
+			[]Item{{ID: "1", Amount: money.New(600, "EUR")}, {ID: "2", Amount: money.New(400, "EUR")}},
+			Options{},
+			nil,
+			[2][]string{{"a"}, {"1", "2"}},
+		},
+		{
+			"currencies",
+			[]Item{{ID: "a", Amount: money.New(1000, "USD")}},
+			[]Item{{ID: "1", Amount: money.New(1000, "EUR")}},
+			Options{},
+			nil,
+			[2][]string{{"a"}, {"1"}},
+		},
+	}
+
+	for _, tc := range tcs {
+		res, err := Reconcile(tc.left, tc.right, tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Matches are compared as "left=right:difference" of item IDs.
+		var matches []string
+		for _, m := range res.Matches {
+			s := ""
+			for _, i := range m.Left {
+				s += i.ID
+			}
+
+			s += "="
+			for _, i := range m.Right {
+				s += i.ID
+			}
+
+			matches = append(matches, s+":"+m.Difference.Display())
+		}
+
+		if !reflect.DeepEqual(matches, tc.matches) {
+			t.Errorf("%s: expected matches %v got %v", tc.name, tc.matches, matches)
+		}
+
+		var unmatched [2][]string
+		for _, i := range res.UnmatchedLeft {
+			unmatched[0] = append(unmatched[0], i.ID)
+		}
+
+		for _, i := range res.UnmatchedRight {
+			unmatched[1] = append(unmatched[1], i.ID)
+		}
+
+		if !reflect.DeepEqual(unmatched, tc.unmatched) {
+			t.Errorf("%s: expected unmatched %v got %v", tc.name, tc.unmatched, unmatched)
+		}
+	}
+}
+
+func TestReconciler_Group(t *testing.T) {
+	tcs := []struct {
+		tolerance int64
+		expected  []int
+	}{
+		{0, nil},
+		{100, []int{0, 2}},
+		{math.MaxInt64, []int{0, 2}},
+	}
+
+	for _, tc := range tcs {
+		r := &reconciler{opts: Options{Tolerance: tc.tolerance}}
+		s := &side{items: []Item{
+			{ID: "1", Amount: money.New(600, "EUR")},
+			{ID: "2", Amount: money.New(200, "EUR")},
+			{ID: "3", Amount: money.New(350, "EUR")},
+		}, used: make([]bool, 3)}
+
+		if g := r.group(Item{ID: "a", Amount: money.New(1000, "EUR")}, s, 2); !reflect.DeepEqual(g, tc.expected) {
+			t.Errorf("Expected group %v with tolerance %d got %v", tc.expected, tc.tolerance, g)
+		}
+	}
+}
+
+func TestReconcile_Errors(t *testing.T) {
+	tcs := []struct {
+		left     []Item
+		opts     Options
+		expected error
+	}{
+		{[]Item{{ID: "a"}}, Options{}, ErrInvalidItem},
+		{nil, Options{Tolerance: -1}, ErrInvalidOptions},
+		{nil, Options{Window: -time.Hour}, ErrInvalidOptions},
+		{nil, Options{MaxGroup: -1}, ErrInvalidOptions},
+	}
+
+	for _, tc := range tcs {
+		res, err := Reconcile(tc.left, nil, tc.opts)
+		if res != nil || !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v got %v", tc.expected, err)
+		}
+	}
+}
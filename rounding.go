@@ -0,0 +1,56 @@
+package money
+
+import "math/big"
+
+// RoundingMode specifies how a value lying between two representable amounts is rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest neighbour, halves are rounded away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest neighbour, halves are rounded towards zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbour, halves are rounded to the even neighbour.
+	RoundHalfEven
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+// quo returns n / d rounded with given mode. Divisor must be positive.
+func (mode RoundingMode) quo(n, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	away := false
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundCeiling:
+		away = n.Sign() > 0
+	case RoundFloor:
+		away = n.Sign() < 0
+	case RoundHalfUp, RoundHalfDown, RoundHalfEven:
+		// Compare remainder with its distance to the next multiple.
+		r.Abs(r)
+		switch r.Cmp(new(big.Int).Sub(d, r)) {
+		case 1:
+			away = true
+		case 0:
+			away = mode == RoundHalfUp || (mode == RoundHalfEven && q.Bit(0) == 1)
+		}
+	}
+
+	if away {
+		q.Add(q, big.NewInt(int64(n.Sign())))
+	}
+
+	return q
+}
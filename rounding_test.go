@@ -0,0 +1,42 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRoundingMode_Quo(t *testing.T) {
+	tcs := []struct {
+		n, d     int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{25, 10, RoundHalfUp, 3},
+		{-25, 10, RoundHalfUp, -3},
+		{24, 10, RoundHalfUp, 2},
+		{25, 10, RoundHalfDown, 2},
+		{-25, 10, RoundHalfDown, -2},
+		{26, 10, RoundHalfDown, 3},
+		{25, 10, RoundHalfEven, 2},
+		{35, 10, RoundHalfEven, 4},
+		{-35, 10, RoundHalfEven, -4},
+		{21, 10, RoundUp, 3},
+		{-21, 10, RoundUp, -3},
+		{29, 10, RoundDown, 2},
+		{-29, 10, RoundDown, -2},
+		{21, 10, RoundCeiling, 3},
+		{-29, 10, RoundCeiling, -2},
+		{29, 10, RoundFloor, 2},
+		{-21, 10, RoundFloor, -3},
+		{20, 10, RoundUp, 2},
+		{0, 10, RoundCeiling, 0},
+	}
+
+	for _, tc := range tcs {
+		r := tc.mode.quo(big.NewInt(tc.n), big.NewInt(tc.d)).Int64()
+
+		if r != tc.expected {
+			t.Errorf("Expected %d / %d with mode %d to be %d got %d", tc.n, tc.d, tc.mode, tc.expected, r)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+type ruleKind int
+
+const (
+	fixedRule ruleKind = iota
+	percentRule
+	remainderRule
+)
+
+// AllocationRule describes how a single party takes its share in AllocateRules.
+// Rules are created with FixedRule, PercentRule or RemainderRule and can be limited with WithMin and WithCap.
+type AllocationRule struct {
+	Name    string
+	kind    ruleKind
+	amount  *Money
+	percent string
+	mode    RoundingMode
+	min     *Money
+	cap     *Money
+}
+
+// RuleStep is an entry of the audit trace produced by AllocateRules.
+type RuleStep struct {
+	// Rule is the name of evaluated rule.
+	Rule string
+	// Amount is the share given to the rule.
+	Amount *Money
+	// Remaining is the Value left to allocate after the rule.
+	Remaining *Money
+	// Explanation describes how the share was calculated.
+	Explanation string
+}
+
+// RuleAllocation is the result of AllocateRules.
+type RuleAllocation struct {
+	// Parts holds the share of every rule in the order rules were given.
+	Parts []*Money
+	// Unallocated is the Value which no rule took.
+	Unallocated *Money
+	// Trace lists the steps in evaluation order.
+	Trace []RuleStep
+}
+
+// FixedRule creates rule taking a fixed amount.
+func FixedRule(name string, amount *Money) AllocationRule {
+	return AllocationRule{Name: name, kind: fixedRule, amount: amount}
+}
+
+// PercentRule creates rule taking given percentage (e.g. "30" or "12.5%") of the total Value,
+// rounded to minor units using given mode.
+func PercentRule(name, percent string, mode RoundingMode) AllocationRule {
+	return AllocationRule{Name: name, kind: percentRule, percent: percent, mode: mode}
+}
+
+// RemainderRule creates rule taking whatever is left after all other rules were evaluated.
+func RemainderRule(name string) AllocationRule {
+	return AllocationRule{Name: name, kind: remainderRule}
+}
+
+// WithMin returns copy of the rule which takes at least given amount, which must not be negative.
+func (r AllocationRule) WithMin(min *Money) AllocationRule {
+	r.min = min
+	return r
+}
+
+// WithCap returns copy of the rule which takes at most given amount, which must not be negative.
+func (r AllocationRule) WithCap(cap *Money) AllocationRule {
+	r.cap = cap
+	return r
+}
+
+// AllocateRules splits Self Value using given rules, e.g. "partner A gets a fixed €5, partner B gets 30%
+// capped at €100, remainder to us". Rules are evaluated deterministically in the given order, except
+// the remainder rule which is always evaluated last. Percentages are taken from the total Value.
+// If a rule asks for more than is left an error is returned.
+func (m *Money) AllocateRules(rules ...AllocationRule) (*RuleAllocation, error) {
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%w: no rules specified", ErrInvalidRule)
+	}
+
+	if m.IsNegative() {
+		return nil, ErrNegativeAmount
+	}
+
+	rest := -1
+	for i, r := range rules {
+		if r.kind == remainderRule {
+			if rest >= 0 {
+				return nil, &RuleError{Rule: r.Name, Err: errors.New("only one remainder rule is allowed")}
+			}
+			rest = i
+		}
+
+		for _, om := range []*Money{r.amount, r.min, r.cap} {
+			if om == nil {
+				continue
+			}
+
+			if err := m.assertSameCurrencyData(om); err != nil {
+				return nil, &RuleError{Rule: r.Name, Err: err}
+			}
+
+			if om.IsNegative() {
+				return nil, &RuleError{Rule: r.Name, Err: fmt.Errorf("%w: %s", ErrNegativeAmount, om.Display())}
+			}
+		}
+
+		if r.min != nil && r.cap != nil && r.min.Amount() > r.cap.Amount() {
+			return nil, &RuleError{Rule: r.Name, Err: fmt.Errorf("minimum %s is higher than cap %s", r.min.Display(), r.cap.Display())}
+		}
+	}
+
+	order := make([]int, 0, len(rules))
+	for i := range rules {
+		if i != rest {
+			order = append(order, i)
+		}
+	}
+
+	if rest >= 0 {
+		order = append(order, rest)
+	}
+
+	ra := &RuleAllocation{Parts: make([]*Money, len(rules))}
+	remaining := m.Amount()
+	for _, i := range order {
+		r := rules[i]
+		share, expl, err := r.share(m, remaining)
+		if err != nil {
+			return nil, err
+		}
+
+		if share > remaining {
+			return nil, &RuleError{Rule: r.Name, Err: fmt.Errorf("share %s exceeds remaining %s",
+				m.withAmount(share).Display(), m.withAmount(remaining).Display())}
+		}
+
+		remaining -= share
+		ra.Parts[i] = m.withAmount(share)
+		ra.Trace = append(ra.Trace, RuleStep{
+			Rule:        r.Name,
+			Amount:      ra.Parts[i],
+			Remaining:   m.withAmount(remaining),
+			Explanation: expl,
+		})
+	}
+
+	ra.Unallocated = m.withAmount(remaining)
+
+	return ra, nil
+}
+
+// share calculates the Value taken by the rule from total m when remaining is left.
+func (r AllocationRule) share(m *Money, remaining int64) (int64, string, error) {
+	var share int64
+	var expl string
+
+	switch r.kind {
+	case fixedRule:
+		if r.amount == nil {
+			return 0, "", &RuleError{Rule: r.Name, Err: errors.New("no amount specified")}
+		}
+
+		share = r.amount.Amount()
+		expl = fmt.Sprintf("fixed %s", r.amount.Display())
+	case percentRule:
+		p, err := parsePercent(r.percent)
+		if err != nil {
+			return 0, "", &RuleError{Rule: r.Name, Err: err}
+		}
+
+		if p.Sign() < 0 {
+			return 0, "", &RuleError{Rule: r.Name, Err: fmt.Errorf("%w: must not be negative", ErrInvalidPercentage)}
+		}
+
+		n := new(big.Int).Mul(big.NewInt(m.Amount()), p.Num())
+		q := r.mode.quo(n, new(big.Int).Mul(p.Denom(), big.NewInt(100)))
+		if !q.IsInt64() {
+			return 0, "", &RuleError{Rule: r.Name, Err: ErrOverflow}
+		}
+
+		share = q.Int64()
+		expl = fmt.Sprintf("%s%% of %s = %s", p.FloatString(precision(p)), m.Display(), m.withAmount(share).Display())
+	case remainderRule:
+		share = remaining
+		expl = fmt.Sprintf("remainder %s", m.withAmount(share).Display())
+	}
+
+	if share < 0 {
+		return 0, "", &RuleError{Rule: r.Name, Err: ErrNegativeAmount}
+	}
+
+	if r.min != nil && share < r.min.Amount() {
+		share = r.min.Amount()
+		expl += fmt.Sprintf(", raised to minimum %s", r.min.Display())
+	}
+
+	if r.cap != nil && share > r.cap.Amount() {
+		share = r.cap.Amount()
+		expl += fmt.Sprintf(", capped at %s", r.cap.Display())
+	}
+
+	return share, expl, nil
+}
+
+// precision returns number of decimal digits needed to print r, limited to 6.
+func precision(r *big.Rat) int {
+	for p := 0; p < 6; p++ {
+		exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p)), nil)
+		if new(big.Rat).Mul(r, new(big.Rat).SetInt(exp)).IsInt() {
+			return p
+		}
+	}
+
+	return 6
+}
@@ -0,0 +1,134 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMoney_AllocateRules(t *testing.T) {
+	total := New(100000, "EUR")
+	ra, err := total.AllocateRules(
+		FixedRule("partner A", New(500, "EUR")),
+		PercentRule("partner B", "30", RoundHalfUp).WithCap(New(10000, "EUR")),
+		RemainderRule("us"),
+	)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rs []int64
+	for _, p := range ra.Parts {
+		rs = append(rs, p.Amount())
+	}
+
+	expected := []int64{500, 10000, 89500}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Expected parts %v got %v", expected, rs)
+	}
+
+	if !ra.Unallocated.IsZero() {
+		t.Errorf("Expected nothing unallocated got %s", ra.Unallocated.Display())
+	}
+
+	steps := []string{
+		"fixed €5.00",
+		"30% of €1,000.00 = €300.00, capped at €100.00",
+		"remainder €895.00",
+	}
+	for i, step := range ra.Trace {
+		if step.Explanation != steps[i] {
+			t.Errorf("Expected step %d to be %q got %q", i, steps[i], step.Explanation)
+		}
+	}
+}
+
+func TestMoney_AllocateRulesOrder(t *testing.T) {
+	total := New(1001, "USD")
+	ra, err := total.AllocateRules(
+		RemainderRule("us"),
+		PercentRule("partner", "12.5", RoundHalfEven).WithMin(New(200, "USD")),
+		PercentRule("agent", "10", RoundDown),
+	)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rs []int64
+	for _, p := range ra.Parts {
+		rs = append(rs, p.Amount())
+	}
+
+	expected := []int64{701, 200, 100}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Expected parts %v got %v", expected, rs)
+	}
+
+	var names []string
+	for _, step := range ra.Trace {
+		names = append(names, step.Rule)
+	}
+
+	if !reflect.DeepEqual([]string{"partner", "agent", "us"}, names) {
+		t.Errorf("Expected remainder rule to be evaluated last got %v", names)
+	}
+
+	if ra.Trace[0].Remaining.Amount() != 801 {
+		t.Errorf("Expected %d remaining got %d", 801, ra.Trace[0].Remaining.Amount())
+	}
+}
+
+func TestMoney_AllocateRulesUnallocated(t *testing.T) {
+	ra, err := New(1000, "EUR").AllocateRules(PercentRule("partner", "30%", RoundHalfUp))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ra.Parts[0].Amount() != 300 || ra.Unallocated.Amount() != 700 {
+		t.Errorf("Expected 300 allocated and 700 unallocated got %d and %d", ra.Parts[0].Amount(),
+			ra.Unallocated.Amount())
+	}
+}
+
+func TestMoney_AllocateRules2(t *testing.T) {
+	tcs := []struct {
+		total *Money
+		rules []AllocationRule
+	}{
+		{New(1000, "EUR"), nil},
+		{New(-1000, "EUR"), []AllocationRule{RemainderRule("us")}},
+		{New(1000, "EUR"), []AllocationRule{RemainderRule("us"), RemainderRule("them")}},
+		{New(1000, "EUR"), []AllocationRule{FixedRule("partner", New(100, "USD"))}},
+		{New(1000, "EUR"), []AllocationRule{FixedRule("partner", New(1001, "EUR"))}},
+		{New(1000, "EUR"), []AllocationRule{PercentRule("partner", "thirty", RoundHalfUp)}},
+		{New(1000, "EUR"), []AllocationRule{PercentRule("partner", "-5", RoundHalfUp)}},
+		{New(1000, "EUR"), []AllocationRule{
+			PercentRule("partner", "60", RoundHalfUp),
+			PercentRule("agent", "60", RoundHalfUp),
+		}},
+		{New(1000, "EUR"), []AllocationRule{
+			PercentRule("partner", "5", RoundHalfUp).WithMin(New(200, "EUR")).WithCap(New(100, "EUR")),
+		}},
+		{New(100000, "EUR"), []AllocationRule{
+			FixedRule("partner", New(500, "EUR")).WithCap(New(-1000, "EUR")),
+			RemainderRule("us"),
+		}},
+		{New(1000, "EUR"), []AllocationRule{PercentRule("partner", "5", RoundHalfUp).WithMin(New(-100, "EUR"))}},
+		{New(1000, "EUR"), []AllocationRule{FixedRule("partner", New(-100, "EUR"))}},
+	}
+
+	for i, tc := range tcs {
+		r, err := tc.total.AllocateRules(tc.rules...)
+
+		if r != nil || err == nil {
+			t.Errorf("Expected err for case %d", i)
+		}
+
+		if len(tc.rules) > 0 && !errors.Is(err, ErrInvalidRule) && !errors.Is(err, ErrNegativeAmount) {
+			t.Errorf("%d: expected %v got %v", i, ErrInvalidRule, err)
+		}
+	}
+}
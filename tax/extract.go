@@ -0,0 +1,155 @@
+package tax
+
+import (
+	"math/big"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/amount"
+)
+
+// Portion is a part of a tax inclusive basket taxed by a single rate.
+type Portion struct {
+	Gross *money.Money
+	Rate  Rate
+}
+
+// ExtractTax splits tax inclusive gross amount into net and tax using given rate,
+// e.g. €10.00 at 19% gives €8.40 net and €1.60 tax. Tax is rounded using given mode
+// and net is the rest, so net + tax == gross exactly.
+func ExtractTax(gross *money.Money, rate Rate, mode money.RoundingMode) (net, tax *money.Money, err error) {
+	p, err := rate.rat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t, err := gross.MultiplyRat(new(big.Rat).Quo(p, new(big.Rat).Add(p, big.NewRat(1, 1))), mode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	net, err = gross.Subtract(t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return net, t, nil
+}
+
+// ExtractBasket splits tax inclusive portions of a basket into net and tax. Total tax of the basket
+// is rounded once using given mode. The rounding difference between the total and the rounded taxes
+// of every rate goes to the rates which lost the most to rounding, earlier rates first on ties, and
+// within a rate to its last portion. Rates of the result are listed in order of appearance.
+func ExtractBasket(portions []Portion, mode money.RoundingMode) (*Result, error) {
+	if len(portions) == 0 {
+		return nil, ErrNoLines
+	}
+
+	lines := make([]Line, len(portions))
+	exact := make([]*big.Rat, len(portions))
+	code := portions[0].Gross.Currency().Code
+	for i, p := range portions {
+		if p.Gross.Currency().Code != code {
+			return nil, &money.CurrencyMismatchError{Left: code, Right: p.Gross.Currency().Code}
+		}
+
+		r := p.Rate
+		r.Inclusive, r.Compound = true, false
+		lines[i] = Line{Amount: p.Gross, Rates: []Rate{r}}
+
+		ts, err := lines[i].exact()
+		if err != nil {
+			return nil, err
+		}
+
+		exact[i] = ts[0]
+	}
+
+	rates := rateOrder(lines)
+	rateExact := make([]*big.Rat, len(rates))
+	rateTax := make([]int64, len(rates))
+	total := new(big.Rat)
+	for k, r := range rates {
+		rateExact[k] = new(big.Rat)
+		for i, l := range lines {
+			if l.Rates[0] == r {
+				rateExact[k].Add(rateExact[k], exact[i])
+			}
+		}
+
+		t, err := amount.Round(rateExact[k], code, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		rateTax[k] = t
+		total.Add(total, rateExact[k])
+	}
+
+	target, err := amount.Round(total, code, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	assignDifference(target, rateExact, rateTax)
+
+	// Spread tax of every rate over its portions by rounding the running total,
+	// the last portion takes what is left.
+	taxes := make([][]int64, len(lines))
+	for k, r := range rates {
+		cum := new(big.Rat)
+		var prev int64
+		last := -1
+		for i, l := range lines {
+			if l.Rates[0] != r {
+				continue
+			}
+
+			cum.Add(cum, exact[i])
+			t, err := amount.Round(cum, code, mode)
+			if err != nil {
+				return nil, err
+			}
+
+			taxes[i] = []int64{t - prev}
+			prev = t
+			last = i
+		}
+
+		taxes[last][0] += rateTax[k] - prev
+	}
+
+	return collect(lines, taxes, code), nil
+}
+
+// assignDifference adjusts rounded taxes one minor unit at a time until they sum to target.
+// Every step adjusts the tax which is furthest from its exact value in the needed direction.
+func assignDifference(target int64, exact []*big.Rat, rounded []int64) {
+	var sum int64
+	for _, t := range rounded {
+		sum += t
+	}
+
+	for diff := target - sum; diff != 0; {
+		step := int64(1)
+		if diff < 0 {
+			step = -1
+		}
+
+		best := -1
+		var bestLoss *big.Rat
+		for k := range exact {
+			// Loss is how much the rounded tax is below (or above, when stepping down) its exact value.
+			loss := new(big.Rat).Sub(exact[k], new(big.Rat).SetInt64(rounded[k]))
+			if step < 0 {
+				loss.Neg(loss)
+			}
+
+			if best < 0 || loss.Cmp(bestLoss) > 0 {
+				best, bestLoss = k, loss
+			}
+		}
+
+		rounded[best] += step
+		diff -= step
+	}
+}
@@ -0,0 +1,118 @@
+package tax
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+func TestExtractTax(t *testing.T) {
+	tcs := []struct {
+		gross   int64
+		percent string
+		net     int64
+		tax     int64
+	}{
+		{1000, "19", 840, 160},
+		{1000, "7", 935, 65},
+		{119, "19", 100, 19},
+		{-1000, "19", -840, -160},
+		{0, "19", 0, 0},
+		{1000, "0", 1000, 0},
+	}
+
+	for _, tc := range tcs {
+		net, tax, err := ExtractTax(money.New(tc.gross, "EUR"), Rate{Name: "VAT", Percent: tc.percent}, money.RoundHalfUp)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if net.Amount() != tc.net || tax.Amount() != tc.tax {
+			t.Errorf("Expected %d at %s%% to be %d + %d got %d + %d", tc.gross, tc.percent, tc.net, tc.tax,
+				net.Amount(), tax.Amount())
+		}
+	}
+
+	if _, _, err := ExtractTax(money.New(1000, "EUR"), Rate{Percent: "x"}, money.RoundHalfUp); !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("Expected %v got %v", ErrInvalidRate, err)
+	}
+}
+
+func TestExtractBasket(t *testing.T) {
+	reduced := Rate{Name: "VAT", Jurisdiction: "DE", Percent: "7", Inclusive: true}
+	res, err := ExtractBasket([]Portion{
+		{Gross: money.New(100, "EUR"), Rate: vatIncl},
+		{Gross: money.New(100, "EUR"), Rate: reduced},
+		{Gross: money.New(100, "EUR"), Rate: vatIncl},
+	}, money.RoundHalfUp)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Exact taxes are 31.93 and 6.54 cents, 38.47 in total.
+	if res.Tax.Amount() != 38 || res.Gross.Amount() != 300 || res.Net.Amount() != 262 {
+		t.Errorf("Expected 262 + 38 = 300 got %d + %d = %d", res.Net.Amount(), res.Tax.Amount(), res.Gross.Amount())
+	}
+
+	var rates []int64
+	for _, ra := range res.Rates {
+		rates = append(rates, ra.Tax.Amount())
+	}
+
+	if !reflect.DeepEqual([]int64{32, 6}, rates) {
+		t.Errorf("Expected rate taxes %v got %v", []int64{32, 6}, rates)
+	}
+
+	var lines []int64
+	for _, l := range res.Lines {
+		lines = append(lines, l.Tax.Amount())
+		if l.Net.Amount()+l.Tax.Amount() != l.Gross.Amount() {
+			t.Errorf("Expected line %d + %d = %d", l.Net.Amount(), l.Tax.Amount(), l.Gross.Amount())
+		}
+	}
+
+	if !reflect.DeepEqual([]int64{16, 6, 16}, lines) {
+		t.Errorf("Expected line taxes %v got %v", []int64{16, 6, 16}, lines)
+	}
+}
+
+func TestExtractBasketDeterministic(t *testing.T) {
+	a := Rate{Name: "A", Percent: "100"}
+	b := Rate{Name: "B", Percent: "100"}
+
+	// Both rates have exact tax of 5.5 cents, the difference to the total of 11 cents goes to the first rate.
+	for _, order := range [][]Rate{{a, b}, {b, a}} {
+		res, err := ExtractBasket([]Portion{
+			{Gross: money.New(11, "EUR"), Rate: order[0]},
+			{Gross: money.New(11, "EUR"), Rate: order[1]},
+		}, money.RoundDown)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Rates[0].Rate.Name != order[0].Name || res.Rates[0].Tax.Amount() != 6 || res.Rates[1].Tax.Amount() != 5 {
+			t.Errorf("Expected %s to get 6 and %s to get 5 got %d and %d", order[0].Name, order[1].Name,
+				res.Rates[0].Tax.Amount(), res.Rates[1].Tax.Amount())
+		}
+	}
+}
+
+func TestExtractBasket2(t *testing.T) {
+	if _, err := ExtractBasket(nil, money.RoundHalfUp); err != ErrNoLines {
+		t.Errorf("Expected %v got %v", ErrNoLines, err)
+	}
+
+	_, err := ExtractBasket([]Portion{
+		{Gross: money.New(100, "EUR"), Rate: vat},
+		{Gross: money.New(100, "USD"), Rate: vat},
+	}, money.RoundHalfUp)
+
+	if !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", money.ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,273 @@
+// Package tax calculates sales taxes such as VAT or GST on top of money.Money.
+//
+// Lines carry one or more rates, e.g. a federal and a provincial rate. Rates may be
+// exclusive (added on top of the line amount) or inclusive (contained in the line amount),
+// and compound rates are charged on the amount including the taxes of preceding rates.
+// Every result guarantees that net + tax == gross exactly.
+package tax
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	money "github.com/Sinojin/go-money"
+	"github.com/Sinojin/go-money/internal/amount"
+	"github.com/Sinojin/go-money/internal/percent"
+)
+
+var (
+	// ErrNoLines is returned when there is nothing to calculate tax for.
+	ErrNoLines = errors.New("no lines given")
+	// ErrInvalidRate is returned when a rate percentage can't be parsed or is negative.
+	ErrInvalidRate = errors.New("invalid tax rate")
+	// ErrMixedInclusion is returned when a line has both inclusive and exclusive rates.
+	ErrMixedInclusion = errors.New("line mixes inclusive and exclusive rates")
+)
+
+// Rate describes a single tax rate.
+type Rate struct {
+	// Name of the tax, e.g. "VAT".
+	Name string
+	// Jurisdiction levying the tax, e.g. "DE" or "CA-QC".
+	Jurisdiction string
+	// Percent is the decimal rate in percent, e.g. "19" or "9.975".
+	Percent string
+	// Inclusive rates are contained in the line amount instead of being added on top.
+	Inclusive bool
+	// Compound rates are charged on the amount including taxes of the preceding rates of a line.
+	Compound bool
+}
+
+// Policy specifies where tax amounts are rounded.
+type Policy int
+
+const (
+	// PerLine rounds tax of every line and rate.
+	PerLine Policy = iota
+	// PerInvoice rounds total tax of every rate once and spreads it over the lines.
+	PerInvoice
+)
+
+// Line is a taxable invoice line. Amount is net for exclusive rates and gross for inclusive rates.
+type Line struct {
+	Amount *money.Money
+	Rates  []Rate
+}
+
+// RateAmount holds taxable base, tax and their sum for a single rate.
+type RateAmount struct {
+	Rate  Rate
+	Net   *money.Money
+	Tax   *money.Money
+	Gross *money.Money
+}
+
+// LineResult holds tax calculated for a single line.
+type LineResult struct {
+	Net   *money.Money
+	Tax   *money.Money
+	Gross *money.Money
+	Taxes []RateAmount
+}
+
+// Result holds tax calculated for all lines. Rates lists totals of every rate in order of appearance.
+type Result struct {
+	Net   *money.Money
+	Tax   *money.Money
+	Gross *money.Money
+	Lines []LineResult
+	Rates []RateAmount
+}
+
+// Calculate calculates taxes of given lines rounding tax amounts to minor units using given policy and mode.
+func Calculate(lines []Line, policy Policy, mode money.RoundingMode) (*Result, error) {
+	if len(lines) == 0 {
+		return nil, ErrNoLines
+	}
+
+	code := lines[0].Amount.Currency().Code
+	exact := make([][]*big.Rat, len(lines))
+	for i, l := range lines {
+		if l.Amount.Currency().Code != code {
+			return nil, &money.CurrencyMismatchError{Left: code, Right: l.Amount.Currency().Code}
+		}
+
+		var err error
+		exact[i], err = l.exact()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	taxes := make([][]int64, len(lines))
+	for i := range lines {
+		taxes[i] = make([]int64, len(lines[i].Rates))
+	}
+
+	switch policy {
+	case PerInvoice:
+		// Round the running total of every rate so line taxes sum to the rounded rate total.
+		for _, r := range rateOrder(lines) {
+			cum := new(big.Rat)
+			var prev int64
+			for i, l := range lines {
+				for j, lr := range l.Rates {
+					if lr != r {
+						continue
+					}
+
+					cum.Add(cum, exact[i][j])
+					t, err := amount.Round(cum, code, mode)
+					if err != nil {
+						return nil, err
+					}
+
+					taxes[i][j] = t - prev
+					prev = t
+				}
+			}
+		}
+	default:
+		for i := range lines {
+			for j := range lines[i].Rates {
+				t, err := amount.Round(exact[i][j], code, mode)
+				if err != nil {
+					return nil, err
+				}
+
+				taxes[i][j] = t
+			}
+		}
+	}
+
+	return collect(lines, taxes, code), nil
+}
+
+// exact returns exact taxes of every rate of the line.
+func (l Line) exact() ([]*big.Rat, error) {
+	// Factor of every rate relative to the net amount.
+	fs := make([]*big.Rat, len(l.Rates))
+	sum := new(big.Rat)
+	for i, r := range l.Rates {
+		if r.Inclusive != l.Rates[0].Inclusive {
+			return nil, ErrMixedInclusion
+		}
+
+		p, err := r.rat()
+		if err != nil {
+			return nil, err
+		}
+
+		fs[i] = p
+		if r.Compound {
+			fs[i] = new(big.Rat).Mul(p, new(big.Rat).Add(big.NewRat(1, 1), sum))
+		}
+
+		sum.Add(sum, fs[i])
+	}
+
+	net := new(big.Rat).SetInt64(l.Amount.Amount())
+	if len(l.Rates) > 0 && l.Rates[0].Inclusive {
+		net.Quo(net, sum.Add(sum, big.NewRat(1, 1)))
+	}
+
+	ts := make([]*big.Rat, len(fs))
+	for i, f := range fs {
+		ts[i] = new(big.Rat).Mul(net, f)
+	}
+
+	return ts, nil
+}
+
+// rat returns rate as a fraction, e.g. 0.19 for 19%.
+func (r Rate) rat() (*big.Rat, error) {
+	p, ok := percent.Parse(r.Percent)
+	if !ok || p.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s %q", ErrInvalidRate, r.Name, r.Percent)
+	}
+
+	return p.Quo(p, big.NewRat(100, 1)), nil
+}
+
+// collect builds the result from rounded taxes of every line and rate.
+func collect(lines []Line, taxes [][]int64, code string) *Result {
+	res := &Result{}
+	totals := make(map[Rate]*[2]int64)
+	var net, tax int64
+	for i, l := range lines {
+		var lt int64
+		for _, t := range taxes[i] {
+			lt += t
+		}
+
+		ln := l.Amount.Amount()
+		if len(l.Rates) > 0 && l.Rates[0].Inclusive {
+			ln -= lt
+		}
+
+		lr := LineResult{
+			Net:   money.New(ln, code),
+			Tax:   money.New(lt, code),
+			Gross: money.New(ln+lt, code),
+		}
+
+		// Compound rates are charged on net including the preceding taxes.
+		base, prior := ln, int64(0)
+		for j, r := range l.Rates {
+			b := base
+			if r.Compound {
+				b = base + prior
+			}
+
+			lr.Taxes = append(lr.Taxes, rateAmount(r, b, taxes[i][j], code))
+			prior += taxes[i][j]
+
+			if _, ok := totals[r]; !ok {
+				totals[r] = &[2]int64{}
+				res.Rates = append(res.Rates, RateAmount{Rate: r})
+			}
+			totals[r][0] += b
+			totals[r][1] += taxes[i][j]
+		}
+
+		res.Lines = append(res.Lines, lr)
+		net += ln
+		tax += lt
+	}
+
+	for i, ra := range res.Rates {
+		res.Rates[i] = rateAmount(ra.Rate, totals[ra.Rate][0], totals[ra.Rate][1], code)
+	}
+
+	res.Net = money.New(net, code)
+	res.Tax = money.New(tax, code)
+	res.Gross = money.New(net+tax, code)
+
+	return res
+}
+
+func rateAmount(r Rate, net, tax int64, code string) RateAmount {
+	return RateAmount{
+		Rate:  r,
+		Net:   money.New(net, code),
+		Tax:   money.New(tax, code),
+		Gross: money.New(net+tax, code),
+	}
+}
+
+// rateOrder returns distinct rates of all lines in order of appearance.
+func rateOrder(lines []Line) []Rate {
+	var rs []Rate
+	seen := make(map[Rate]bool)
+	for _, l := range lines {
+		for _, r := range l.Rates {
+			if !seen[r] {
+				seen[r] = true
+				rs = append(rs, r)
+			}
+		}
+	}
+
+	return rs
+}
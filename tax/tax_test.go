@@ -0,0 +1,167 @@
+package tax
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	money "github.com/Sinojin/go-money"
+)
+
+var (
+	vat     = Rate{Name: "VAT", Jurisdiction: "DE", Percent: "19"}
+	vatIncl = Rate{Name: "VAT", Jurisdiction: "DE", Percent: "19", Inclusive: true}
+	gst     = Rate{Name: "GST", Jurisdiction: "CA", Percent: "5"}
+	qst     = Rate{Name: "QST", Jurisdiction: "CA-QC", Percent: "9.975"}
+	pst     = Rate{Name: "PST", Jurisdiction: "CA-PE", Percent: "10", Compound: true}
+)
+
+func TestCalculate(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		rates  []Rate
+		net    int64
+		taxes  []int64
+		gross  int64
+	}{
+		{1000, []Rate{vat}, 1000, []int64{190}, 1190},
+		{999, []Rate{vat}, 999, []int64{190}, 1189},
+		{10000, []Rate{gst, qst}, 10000, []int64{500, 998}, 11498},
+		{10000, []Rate{gst, pst}, 10000, []int64{500, 1050}, 11550},
+		{1000, []Rate{vatIncl}, 840, []int64{160}, 1000},
+		{-1000, []Rate{vat}, -1000, []int64{-190}, -1190},
+		{1000, nil, 1000, nil, 1000},
+	}
+
+	for _, tc := range tcs {
+		res, err := Calculate([]Line{{Amount: money.New(tc.amount, "EUR"), Rates: tc.rates}}, PerLine, money.RoundHalfUp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		l := res.Lines[0]
+		var taxes []int64
+		for _, ra := range l.Taxes {
+			taxes = append(taxes, ra.Tax.Amount())
+		}
+
+		if l.Net.Amount() != tc.net || !reflect.DeepEqual(taxes, tc.taxes) || l.Gross.Amount() != tc.gross {
+			t.Errorf("Expected %d with %v to be %d + %v = %d got %d + %v = %d", tc.amount, tc.rates, tc.net,
+				tc.taxes, tc.gross, l.Net.Amount(), taxes, l.Gross.Amount())
+		}
+	}
+}
+
+func TestCalculate_Compound(t *testing.T) {
+	res, err := Calculate([]Line{{Amount: money.New(10000, "CAD"), Rates: []Rate{gst, pst}}}, PerLine, money.RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if base := res.Rates[1].Net.Amount(); base != 10500 {
+		t.Errorf("Expected compound base %d got %d", 10500, base)
+	}
+}
+
+func TestCalculate_Policy(t *testing.T) {
+	lines := []Line{
+		{Amount: money.New(5, "EUR"), Rates: []Rate{{Name: "T", Percent: "10"}}},
+		{Amount: money.New(5, "EUR"), Rates: []Rate{{Name: "T", Percent: "10"}}},
+		{Amount: money.New(5, "EUR"), Rates: []Rate{{Name: "T", Percent: "10"}}},
+	}
+
+	tcs := []struct {
+		policy   Policy
+		lines    []int64
+		expected int64
+	}{
+		{PerLine, []int64{1, 1, 1}, 3},
+		{PerInvoice, []int64{1, 0, 1}, 2},
+	}
+
+	for _, tc := range tcs {
+		res, err := Calculate(lines, tc.policy, money.RoundHalfUp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var taxes []int64
+		for _, l := range res.Lines {
+			taxes = append(taxes, l.Tax.Amount())
+		}
+
+		if !reflect.DeepEqual(taxes, tc.lines) || res.Tax.Amount() != tc.expected || res.Rates[0].Tax.Amount() != tc.expected {
+			t.Errorf("Expected policy %d to give %v = %d got %v = %d", tc.policy, tc.lines, tc.expected, taxes,
+				res.Tax.Amount())
+		}
+	}
+}
+
+func TestCalculate_Balances(t *testing.T) {
+	var lines []Line
+	for i := int64(1); i < 40; i++ {
+		rates := []Rate{gst, qst}
+		if i%3 == 0 {
+			rates = []Rate{vatIncl}
+		} else if i%3 == 1 {
+			rates = []Rate{gst, pst}
+		}
+
+		lines = append(lines, Line{Amount: money.New(i*137, "EUR"), Rates: rates})
+	}
+
+	for _, policy := range []Policy{PerLine, PerInvoice} {
+		res, err := Calculate(lines, policy, money.RoundHalfEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		balanced := func(net, tax, gross *money.Money) bool {
+			sum, err := net.Add(tax)
+			return err == nil && sum.Amount() == gross.Amount()
+		}
+
+		if !balanced(res.Net, res.Tax, res.Gross) {
+			t.Errorf("Expected invoice %s + %s = %s", res.Net.Display(), res.Tax.Display(), res.Gross.Display())
+		}
+
+		for _, l := range res.Lines {
+			if !balanced(l.Net, l.Tax, l.Gross) {
+				t.Errorf("Expected line %s + %s = %s", l.Net.Display(), l.Tax.Display(), l.Gross.Display())
+			}
+		}
+
+		var tax int64
+		for _, ra := range res.Rates {
+			tax += ra.Tax.Amount()
+			if !balanced(ra.Net, ra.Tax, ra.Gross) {
+				t.Errorf("Expected rate %s + %s = %s", ra.Net.Display(), ra.Tax.Display(), ra.Gross.Display())
+			}
+		}
+
+		if tax != res.Tax.Amount() {
+			t.Errorf("Expected rate taxes to sum to %d got %d", res.Tax.Amount(), tax)
+		}
+	}
+}
+
+func TestCalculate2(t *testing.T) {
+	tcs := []struct {
+		lines    []Line
+		expected error
+	}{
+		{nil, ErrNoLines},
+		{[]Line{{Amount: money.New(100, "EUR"), Rates: []Rate{vat, vatIncl}}}, ErrMixedInclusion},
+		{[]Line{{Amount: money.New(100, "EUR"), Rates: []Rate{{Name: "X", Percent: "ten"}}}}, ErrInvalidRate},
+		{[]Line{{Amount: money.New(100, "EUR"), Rates: []Rate{{Name: "X", Percent: "-1"}}}}, ErrInvalidRate},
+		{[]Line{{Amount: money.New(100, "EUR")}, {Amount: money.New(100, "USD")}}, money.ErrCurrencyMismatch},
+	}
+
+	for i, tc := range tcs {
+		res, err := Calculate(tc.lines, PerLine, money.RoundHalfUp)
+
+		if res != nil || !errors.Is(err, tc.expected) {
+			t.Errorf("%d: expected %v got %v", i, tc.expected, err)
+		}
+	}
+}
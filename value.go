@@ -0,0 +1,158 @@
+package money
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Value is an immutable value type representation of Money. Unlike Money it holds no pointers,
+// so it is safe to copy, comparable with == and usable as a map key, and its arithmetic doesn't allocate.
+// Use Money.Value and Value.Money to convert between the two representations.
+type Value struct {
+	amount int64
+	code   string
+}
+
+// NewValue creates and returns new Value.
+func NewValue(amount int64, code string) Value {
+	return Value{amount: amount, code: strings.ToUpper(code)}
+}
+
+// Value returns Value type copy of Money.
+func (m *Money) Value() Value {
+	return Value{amount: m.Amount(), code: m.currency().Code}
+}
+
+// Money returns pointer based Money copy of Value.
+func (v Value) Money() *Money {
+	return New(v.amount, v.code)
+}
+
+// Amount returns the monetary Value as an int64.
+func (v Value) Amount() int64 {
+	return v.amount
+}
+
+// Code returns the currency code of Value.
+func (v Value) Code() string {
+	return v.code
+}
+
+// Currency returns the currency used by Value.
+func (v Value) Currency() *Currency {
+	return newCurrency(v.code).get()
+}
+
+// SameCurrency check if given Value is equals by currency.
+func (v Value) SameCurrency(ov Value) bool {
+	return v.code == ov.code
+}
+
+// Compare returns -1, 0 or 1 when Value is less than, equal to or greater than the other.
+func (v Value) Compare(ov Value) (int, error) {
+	if !v.SameCurrency(ov) {
+		return 0, &CurrencyMismatchError{Left: v.code, Right: ov.code}
+	}
+
+	switch {
+	case v.amount > ov.amount:
+		return 1, nil
+	case v.amount < ov.amount:
+		return -1, nil
+	}
+
+	return 0, nil
+}
+
+// IsZero returns boolean of whether the Value is equals to zero.
+func (v Value) IsZero() bool {
+	return v.amount == 0
+}
+
+// IsPositive returns boolean of whether the Value is positive.
+func (v Value) IsPositive() bool {
+	return v.amount > 0
+}
+
+// IsNegative returns boolean of whether the Value is negative.
+func (v Value) IsNegative() bool {
+	return v.amount < 0
+}
+
+// Absolute returns absolute Value.
+func (v Value) Absolute() Value {
+	if v.amount < 0 {
+		v.amount = -v.amount
+	}
+
+	return v
+}
+
+// Negative returns negative Value.
+func (v Value) Negative() Value {
+	if v.amount > 0 {
+		v.amount = -v.amount
+	}
+
+	return v
+}
+
+// Add returns Value representing sum of Self and Other Value.
+func (v Value) Add(ov Value) (Value, error) {
+	if !v.SameCurrency(ov) {
+		return Value{}, &CurrencyMismatchError{Left: v.code, Right: ov.code}
+	}
+
+	v.amount += ov.amount
+
+	return v, nil
+}
+
+// Subtract returns Value representing difference of Self and Other Value.
+func (v Value) Subtract(ov Value) (Value, error) {
+	if !v.SameCurrency(ov) {
+		return Value{}, &CurrencyMismatchError{Left: v.code, Right: ov.code}
+	}
+
+	v.amount -= ov.amount
+
+	return v, nil
+}
+
+// Multiply returns Value multiplied by multiplier.
+func (v Value) Multiply(mul int64) Value {
+	v.amount *= mul
+	return v
+}
+
+// Display lets represent Value as string in its currency.
+func (v Value) Display() string {
+	return v.Currency().Formatter().Format(v.amount)
+}
+
+// String is implementation of fmt.Stringer.
+func (v Value) String() string {
+	return v.Display()
+}
+
+// MarshalJSON is implementation of json.Marshaller. Value uses the same
+// encoding as the default encoding of Money.
+func (v Value) MarshalJSON() ([]byte, error) {
+	return defaultMarshalJSON(*v.Money())
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var data struct {
+		AmountData   int64
+		CurrencyData string
+	}
+
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	*v = NewValue(data.AmountData, data.CurrencyData)
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	v := NewValue(100, "eur")
+
+	if v.Amount() != 100 || v.Code() != "EUR" || v.Currency().Grapheme != "€" {
+		t.Errorf("Expected €1.00 got %s", v.Display())
+	}
+
+	if v != NewValue(100, "EUR") {
+		t.Error("Expected values to be comparable")
+	}
+
+	totals := map[Value]int{v: 1}
+	if totals[NewValue(100, "EUR")] != 1 {
+		t.Error("Expected value to be usable as map key")
+	}
+}
+
+func TestValue_Arithmetic(t *testing.T) {
+	a := NewValue(100, "EUR")
+	b := NewValue(-30, "EUR")
+
+	sum, err := a.Add(b)
+	if err != nil || sum != NewValue(70, "EUR") {
+		t.Errorf("Expected %s got %s, %v", NewValue(70, "EUR"), sum, err)
+	}
+
+	diff, err := a.Subtract(b)
+	if err != nil || diff != NewValue(130, "EUR") {
+		t.Errorf("Expected %s got %s, %v", NewValue(130, "EUR"), diff, err)
+	}
+
+	if a.Multiply(3) != NewValue(300, "EUR") {
+		t.Errorf("Expected %s got %s", NewValue(300, "EUR"), a.Multiply(3))
+	}
+
+	if b.Absolute() != NewValue(30, "EUR") || a.Negative() != NewValue(-100, "EUR") {
+		t.Errorf("Expected absolute and negative values got %s and %s", b.Absolute(), a.Negative())
+	}
+
+	if a.Amount() != 100 {
+		t.Errorf("Expected operations not to modify value got %d", a.Amount())
+	}
+
+	if _, err := a.Add(NewValue(1, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err := a.Subtract(NewValue(1, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestValue_Compare(t *testing.T) {
+	tcs := []struct {
+		a, b     Value
+		expected int
+	}{
+		{NewValue(1, "EUR"), NewValue(2, "EUR"), -1},
+		{NewValue(2, "EUR"), NewValue(2, "EUR"), 0},
+		{NewValue(3, "EUR"), NewValue(2, "EUR"), 1},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.a.Compare(tc.b)
+
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %s compared to %s to be %d got %d", tc.a, tc.b, tc.expected, r)
+		}
+	}
+
+	if _, err := NewValue(1, "EUR").Compare(NewValue(1, "USD")); err == nil {
+		t.Error("Expected err")
+	}
+}
+
+func TestValue_Asserts(t *testing.T) {
+	if !NewValue(0, "EUR").IsZero() || !NewValue(1, "EUR").IsPositive() || !NewValue(-1, "EUR").IsNegative() {
+		t.Error("Expected asserts to match amount sign")
+	}
+}
+
+func TestValue_Money(t *testing.T) {
+	m := New(12345, "GBP")
+	v := m.Value()
+
+	if v != NewValue(12345, "GBP") {
+		t.Errorf("Expected %s got %s", NewValue(12345, "GBP"), v)
+	}
+
+	if v.Money().Display() != m.Display() {
+		t.Errorf("Expected %s got %s", m.Display(), v.Money().Display())
+	}
+}
+
+func TestValue_JSON(t *testing.T) {
+	v := NewValue(12345, "IQD")
+	expected := `{"AmountData":12345,"CurrencyData":"IQD"}`
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	var rv Value
+	if err := json.Unmarshal(b, &rv); err != nil {
+		t.Fatal(err)
+	}
+
+	if rv != v {
+		t.Errorf("Expected %s got %s", v, rv)
+	}
+}
+
+func TestValue_Allocations(t *testing.T) {
+	a := NewValue(100, "EUR")
+	b := NewValue(200, "EUR")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		r, _ := a.Add(b)
+		r, _ = r.Subtract(b)
+		_ = r.Multiply(2).Negative().Absolute()
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected no allocations got %f", allocs)
+	}
+}
+
+// Package level sinks keep the compiler from optimizing benchmarked operations away.
+var (
+	moneySink *Money
+	valueSink Value
+)
+
+func BenchmarkMoney_Add(b *testing.B) {
+	m := New(100, "EUR")
+	om := New(200, "EUR")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		moneySink, _ = m.Add(om)
+	}
+}
+
+func BenchmarkValue_Add(b *testing.B) {
+	v := NewValue(100, "EUR")
+	ov := NewValue(200, "EUR")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		valueSink, _ = v.Add(ov)
+	}
+}
+
+func BenchmarkMoney_Multiply(b *testing.B) {
+	m := New(100, "EUR")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		moneySink = m.Multiply(3)
+	}
+}
+
+func BenchmarkValue_Multiply(b *testing.B) {
+	v := NewValue(100, "EUR")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		valueSink = v.Multiply(3)
+	}
+}
@@ -0,0 +1,515 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// UnitName is the name of a currency unit in a language.
+type UnitName struct {
+	// One is the name of a single unit, e.g. "penny".
+	One string
+	// Other is the name of any other number of units, e.g. "pence".
+	Other string
+	// Feminine units take feminine numerals in languages which have them, e.g. "une livre".
+	Feminine bool
+}
+
+// unitNames holds names of major and minor units of a currency. Minor name is empty when
+// the currency has no minor units or their name is unknown.
+type unitNames struct {
+	major UnitName
+	minor UnitName
+}
+
+// currencyWordsMu guards currencyWords which AddUnitNames may change concurrently with InWords.
+var currencyWordsMu sync.RWMutex
+
+// currencyWords holds unit names of currencies by language and currency code.
+var currencyWords = map[string]map[string]unitNames{
+	"en": {
+		"AUD": {UnitName{"dollar", "dollars", false}, UnitName{"cent", "cents", false}},
+		"BHD": {UnitName{"dinar", "dinars", false}, UnitName{"fils", "fils", false}},
+		"CAD": {UnitName{"dollar", "dollars", false}, UnitName{"cent", "cents", false}},
+		"CHF": {UnitName{"franc", "francs", false}, UnitName{"centime", "centimes", false}},
+		"EUR": {UnitName{"euro", "euros", false}, UnitName{"cent", "cents", false}},
+		"GBP": {UnitName{"pound", "pounds", false}, UnitName{"penny", "pence", false}},
+		"JPY": {UnitName{"yen", "yen", false}, UnitName{}},
+		"USD": {UnitName{"dollar", "dollars", false}, UnitName{"cent", "cents", false}},
+	},
+	"de": {
+		"AUD": {UnitName{"Dollar", "Dollar", false}, UnitName{"Cent", "Cent", false}},
+		"BHD": {UnitName{"Dinar", "Dinar", false}, UnitName{"Fils", "Fils", false}},
+		"CAD": {UnitName{"Dollar", "Dollar", false}, UnitName{"Cent", "Cent", false}},
+		"CHF": {UnitName{"Franken", "Franken", false}, UnitName{"Rappen", "Rappen", false}},
+		"EUR": {UnitName{"Euro", "Euro", false}, UnitName{"Cent", "Cent", false}},
+		"GBP": {UnitName{"Pfund", "Pfund", false}, UnitName{"Penny", "Pence", false}},
+		"JPY": {UnitName{"Yen", "Yen", false}, UnitName{}},
+		"USD": {UnitName{"Dollar", "Dollar", false}, UnitName{"Cent", "Cent", false}},
+	},
+	"fr": {
+		"AUD": {UnitName{"dollar", "dollars", false}, UnitName{"cent", "cents", false}},
+		"BHD": {UnitName{"dinar", "dinars", false}, UnitName{"fils", "fils", false}},
+		"CAD": {UnitName{"dollar", "dollars", false}, UnitName{"cent", "cents", false}},
+		"CHF": {UnitName{"franc", "francs", false}, UnitName{"centime", "centimes", false}},
+		"EUR": {UnitName{"euro", "euros", false}, UnitName{"centime", "centimes", false}},
+		"GBP": {UnitName{"livre", "livres", true}, UnitName{"penny", "pence", false}},
+		"JPY": {UnitName{"yen", "yens", false}, UnitName{}},
+		"USD": {UnitName{"dollar", "dollars", false}, UnitName{"cent", "cents", false}},
+	},
+	"es": {
+		"AUD": {UnitName{"dólar", "dólares", false}, UnitName{"centavo", "centavos", false}},
+		"BHD": {UnitName{"dinar", "dinares", false}, UnitName{"fils", "fils", false}},
+		"CAD": {UnitName{"dólar", "dólares", false}, UnitName{"centavo", "centavos", false}},
+		"CHF": {UnitName{"franco", "francos", false}, UnitName{"céntimo", "céntimos", false}},
+		"EUR": {UnitName{"euro", "euros", false}, UnitName{"céntimo", "céntimos", false}},
+		"GBP": {UnitName{"libra", "libras", true}, UnitName{"penique", "peniques", false}},
+		"JPY": {UnitName{"yen", "yenes", false}, UnitName{}},
+		"USD": {UnitName{"dólar", "dólares", false}, UnitName{"centavo", "centavos", false}},
+	},
+}
+
+// AddUnitNames adds or replaces names of major and minor units of the currency in a supported language.
+// Major name needs both forms. Minor name may be empty, minor units are then written as a fraction,
+// e.g. "and 56/100".
+func AddUnitNames(lang, code string, major, minor UnitName) error {
+	lang = wordsLanguageCode(lang)
+	if _, ok := wordsLanguages[lang]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang)
+	}
+
+	if major.One == "" || major.Other == "" {
+		return fmt.Errorf("%w: major unit of %s needs singular and plural", ErrInvalidUnitName, code)
+	}
+
+	if (minor.One == "") != (minor.Other == "") {
+		return fmt.Errorf("%w: minor unit of %s needs singular and plural", ErrInvalidUnitName, code)
+	}
+
+	currencyWordsMu.Lock()
+	currencyWords[lang][strings.ToUpper(code)] = unitNames{major: major, minor: minor}
+	currencyWordsMu.Unlock()
+
+	return nil
+}
+
+// wordsLanguage spells out amounts in a language.
+type wordsLanguage struct {
+	minus string
+	and   string
+	// spell returns cardinal number n preceding a noun of given gender.
+	spell func(n uint64, feminine bool) string
+	// plural reports whether n units take the plural name.
+	plural func(n uint64) bool
+	// of returns unit name preceded by a preposition when numbers such as "million" require it.
+	of func(n uint64, unit string) string
+}
+
+var wordsLanguages = map[string]wordsLanguage{
+	"en": {minus: "minus", and: "and", spell: spellEnglish, plural: notOne, of: plain},
+	"de": {minus: "minus", and: "und", spell: spellGerman, plural: notOne, of: plain},
+	"fr": {minus: "moins", and: "et", spell: spellFrench, plural: func(n uint64) bool { return n > 1 }, of: ofFrench},
+	"es": {minus: "menos", and: "con", spell: spellSpanish, plural: notOne, of: ofSpanish},
+}
+
+// InWords spells out Money in given language, e.g. "One thousand two hundred thirty-four euros and
+// fifty-six cents". Languages en, de, fr and es are supported, regional variants such as "en-GB" use
+// their language. Currencies without unit names use their code and minor units are written
+// as a fraction when their name is unknown, e.g. "Zero PLN and 05/100". It is safe for concurrent
+// use with AddUnitNames. ErrInvalidMoney is returned for Money which is not Valid.
+func (m *Money) InWords(lang string) (string, error) {
+	if !m.Valid() {
+		return "", ErrInvalidMoney
+	}
+
+	lang = wordsLanguageCode(lang)
+	l, ok := wordsLanguages[lang]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang)
+	}
+
+	c := m.currency().get()
+	currencyWordsMu.RLock()
+	names, ok := currencyWords[lang][c.Code]
+	currencyWordsMu.RUnlock()
+	if !ok {
+		names.major = UnitName{One: c.Code, Other: c.Code}
+	}
+
+	a := uint64(m.Amount())
+	if m.Amount() < 0 {
+		a = -a
+	}
+
+	div := uint64(pow10(c.Fraction).Int64())
+	major, minor := a/div, a%div
+
+	var ws []string
+	if m.Amount() < 0 {
+		ws = append(ws, l.minus)
+	}
+
+	ws = append(ws, l.spell(major, names.major.Feminine), l.of(major, unitName(l, names.major, major)))
+
+	switch {
+	case minor == 0:
+	case names.minor.One == "":
+		ws = append(ws, l.and, fmt.Sprintf("%0*d/%d", c.Fraction, minor, div))
+	default:
+		ws = append(ws, l.and, l.spell(minor, names.minor.Feminine), unitName(l, names.minor, minor))
+	}
+
+	s := strings.Join(ws, " ")
+	r, size := utf8.DecodeRuneInString(s)
+
+	return string(unicode.ToUpper(r)) + s[size:], nil
+}
+
+// wordsLanguageCode returns lower case language of a locale, e.g. "en" of "en_GB".
+func wordsLanguageCode(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+
+	return lang
+}
+
+func unitName(l wordsLanguage, u UnitName, n uint64) string {
+	if l.plural(n) {
+		return u.Other
+	}
+
+	return u.One
+}
+
+func notOne(n uint64) bool {
+	return n != 1
+}
+
+func plain(n uint64, unit string) string {
+	return unit
+}
+
+// scaled calls f with groups of n by scale in thousands, from the highest non zero group.
+func scaled(n uint64, f func(group uint64, scale int)) {
+	var groups []uint64
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, n%1000)
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] > 0 {
+			f(groups[i], i)
+		}
+	}
+}
+
+var (
+	englishOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+func spellEnglish(n uint64, feminine bool) string {
+	if n == 0 {
+		return englishOnes[0]
+	}
+
+	var ws []string
+	scaled(n, func(g uint64, scale int) {
+		if h := g / 100; h > 0 {
+			ws = append(ws, englishOnes[h], "hundred")
+		}
+
+		switch r := g % 100; {
+		case r >= 20 && r%10 > 0:
+			ws = append(ws, englishTens[r/10]+"-"+englishOnes[r%10])
+		case r >= 20:
+			ws = append(ws, englishTens[r/10])
+		case r > 0:
+			ws = append(ws, englishOnes[r])
+		}
+
+		if scale > 0 {
+			ws = append(ws, englishScales[scale])
+		}
+	})
+
+	return strings.Join(ws, " ")
+}
+
+var (
+	germanOnes = []string{"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun", "zehn",
+		"elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn"}
+	germanTens   = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	germanScales = [][2]string{{}, {}, {"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"},
+		{"Billiarde", "Billiarden"}, {"Trillion", "Trillionen"}}
+)
+
+// germanUnder1000 spells 0 < n < 1000 as a single word ending with "eins" for one.
+func germanUnder1000(n uint64) string {
+	var s string
+	if h := n / 100; h > 0 {
+		s = germanPrefix(germanOnes[h]) + "hundert"
+	}
+
+	switch r := n % 100; {
+	case r >= 20 && r%10 > 0:
+		s += germanPrefix(germanOnes[r%10]) + "und" + germanTens[r/10]
+	case r >= 20:
+		s += germanTens[r/10]
+	case r > 0:
+		s += germanOnes[r]
+	}
+
+	return s
+}
+
+// germanPrefix returns number w preceding another word, e.g. "ein" of "eins".
+func germanPrefix(w string) string {
+	if strings.HasSuffix(w, "eins") {
+		return strings.TrimSuffix(w, "s")
+	}
+
+	return w
+}
+
+// spellGerman writes numbers below a million as a single word, e.g. "eintausendzweihundertvierunddreißig".
+func spellGerman(n uint64, feminine bool) string {
+	if n == 0 {
+		return germanOnes[0]
+	}
+
+	var ws []string
+	var word string
+	scaled(n, func(g uint64, scale int) {
+		switch {
+		case scale == 0:
+			word += germanUnder1000(g)
+		case scale == 1:
+			word += germanPrefix(germanUnder1000(g)) + "tausend"
+		case g == 1:
+			ws = append(ws, "eine", germanScales[scale][0])
+		default:
+			ws = append(ws, germanPrefix(germanUnder1000(g)), germanScales[scale][1])
+		}
+	})
+
+	if strings.HasSuffix(word, "eins") {
+		word = germanPrefix(word)
+		if feminine {
+			word += "e"
+		}
+	}
+
+	if word != "" {
+		ws = append(ws, word)
+	}
+
+	return strings.Join(ws, " ")
+}
+
+var (
+	frenchOnes = []string{"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf", "dix",
+		"onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf"}
+	frenchTens   = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+	frenchScales = [][2]string{{}, {}, {"million", "millions"}, {"milliard", "milliards"}, {"billion", "billions"},
+		{"billiard", "billiards"}, {"trillion", "trillions"}}
+)
+
+// frenchUnder100 spells 0 < n < 100, final reports whether no "mille" follows.
+func frenchUnder100(n uint64, final bool) string {
+	switch {
+	case n < 20:
+		return frenchOnes[n]
+	case n < 70 && n%10 == 1:
+		return frenchTens[n/10] + " et un"
+	case n < 70 && n%10 > 0:
+		return frenchTens[n/10] + "-" + frenchOnes[n%10]
+	case n < 70:
+		return frenchTens[n/10]
+	case n == 71:
+		return "soixante et onze"
+	case n < 80:
+		return "soixante-" + frenchOnes[n-60]
+	case n == 80 && final:
+		return "quatre-vingts"
+	case n == 80:
+		return "quatre-vingt"
+	default:
+		return "quatre-vingt-" + frenchOnes[n-80]
+	}
+}
+
+// frenchUnder1000 spells 0 < n < 1000, final reports whether no "mille" follows.
+func frenchUnder1000(n uint64, final bool) string {
+	h, r := n/100, n%100
+	var ws []string
+	switch {
+	case h == 1:
+		ws = append(ws, "cent")
+	case h > 1 && r == 0 && final:
+		ws = append(ws, frenchOnes[h], "cents")
+	case h > 1:
+		ws = append(ws, frenchOnes[h], "cent")
+	}
+
+	if r > 0 {
+		ws = append(ws, frenchUnder100(r, final))
+	}
+
+	return strings.Join(ws, " ")
+}
+
+func spellFrench(n uint64, feminine bool) string {
+	if n == 0 {
+		return frenchOnes[0]
+	}
+
+	var ws []string
+	scaled(n, func(g uint64, scale int) {
+		switch {
+		case scale == 0:
+			ws = append(ws, frenchUnder1000(g, true))
+		case scale == 1 && g == 1:
+			ws = append(ws, "mille")
+		case scale == 1:
+			ws = append(ws, frenchUnder1000(g, false), "mille")
+		case g == 1:
+			ws = append(ws, "un", frenchScales[scale][0])
+		default:
+			ws = append(ws, frenchUnder1000(g, true), frenchScales[scale][1])
+		}
+	})
+
+	s := strings.Join(ws, " ")
+	if feminine && n%1000 != 0 && (s == "un" || strings.HasSuffix(s, " un") || strings.HasSuffix(s, "-un")) {
+		s += "e"
+	}
+
+	return s
+}
+
+// ofFrench adds "de" before units of round millions, e.g. "un million d'euros".
+func ofFrench(n uint64, unit string) string {
+	if unit == "" || n < 1000000 || n%1000000 != 0 {
+		return unit
+	}
+
+	if strings.ContainsRune("aeiouéè", []rune(unit)[0]) {
+		return "d'" + unit
+	}
+
+	return "de " + unit
+}
+
+var (
+	spanishOnes = []string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve", "diez",
+		"once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve", "veinte",
+		"veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete",
+		"veintiocho", "veintinueve"}
+	spanishTens     = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	spanishHundreds = []string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos",
+		"setecientos", "ochocientos", "novecientos"}
+	spanishScales = [][2]string{{"millón", "millones"}, {"billón", "billones"}, {"trillón", "trillones"}}
+)
+
+// spanishUnder1000 spells 0 < n < 1000 ending with "uno" for one, hundreds agree with the gender.
+func spanishUnder1000(n uint64, feminine bool) string {
+	if n == 100 {
+		return "cien"
+	}
+
+	h, r := n/100, n%100
+	var ws []string
+	if h > 0 {
+		w := spanishHundreds[h]
+		if feminine && h > 1 {
+			w = strings.TrimSuffix(w, "os") + "as"
+		}
+
+		ws = append(ws, w)
+	}
+
+	switch {
+	case r >= 30 && r%10 > 0:
+		ws = append(ws, spanishTens[r/10], "y", spanishOnes[r%10])
+	case r >= 30:
+		ws = append(ws, spanishTens[r/10])
+	case r > 0:
+		ws = append(ws, spanishOnes[r])
+	}
+
+	return strings.Join(ws, " ")
+}
+
+// spanishApocope shortens "uno" before a noun, e.g. "veintiún mil" or "treinta y un euros".
+func spanishApocope(s string, feminine bool) string {
+	switch {
+	case !strings.HasSuffix(s, "uno"):
+		return s
+	case feminine:
+		return strings.TrimSuffix(s, "o") + "a"
+	case strings.HasSuffix(s, "veintiuno"):
+		return strings.TrimSuffix(s, "uno") + "ún"
+	default:
+		return strings.TrimSuffix(s, "o")
+	}
+}
+
+// spanishUnderMillion spells 0 < n < 1000000 before a noun of given gender.
+func spanishUnderMillion(n uint64, feminine bool) string {
+	var ws []string
+	if t := n / 1000; t == 1 {
+		ws = append(ws, "mil")
+	} else if t > 1 {
+		ws = append(ws, spanishApocope(spanishUnder1000(t, feminine), false), "mil")
+	}
+
+	if r := n % 1000; r > 0 {
+		ws = append(ws, spanishApocope(spanishUnder1000(r, feminine), feminine))
+	}
+
+	return strings.Join(ws, " ")
+}
+
+// spellSpanish uses the long scale, e.g. "mil millones" for 10^9 and "un billón" for 10^12.
+func spellSpanish(n uint64, feminine bool) string {
+	if n == 0 {
+		return spanishOnes[0]
+	}
+
+	var groups []uint64
+	for ; n > 0; n /= 1000000 {
+		groups = append(groups, n%1000000)
+	}
+
+	var ws []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		switch g := groups[i]; {
+		case g == 0:
+		case i == 0:
+			ws = append(ws, spanishUnderMillion(g, feminine))
+		case g == 1:
+			ws = append(ws, "un", spanishScales[i-1][0])
+		default:
+			ws = append(ws, spanishUnderMillion(g, false), spanishScales[i-1][1])
+		}
+	}
+
+	return strings.Join(ws, " ")
+}
+
+// ofSpanish adds "de" before units of round millions, e.g. "un millón de euros".
+func ofSpanish(n uint64, unit string) string {
+	if n < 1000000 || n%1000000 != 0 {
+		return unit
+	}
+
+	return "de " + unit
+}
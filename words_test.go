@@ -0,0 +1,190 @@
+package money
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestMoney_InWords(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		lang     string
+		expected string
+	}{
+		{123456, "EUR", "en", "One thousand two hundred thirty-four euros and fifty-six cents"},
+		{100, "USD", "en-US", "One dollar"},
+		{101, "GBP", "en_GB", "One pound and one penny"},
+		{5, "GBP", "en", "Zero pounds and five pence"},
+		{-2100, "USD", "en", "Minus twenty-one dollars"},
+		{1000000000, "USD", "en", "Ten million dollars"},
+		{123456, "JPY", "en", "One hundred twenty-three thousand four hundred fifty-six yen"},
+		{1234567, "BHD", "en", "One thousand two hundred thirty-four dinars and five hundred sixty-seven fils"},
+		{1001, "BHD", "en", "One dinar and one fils"},
+		{1250, "PLN", "en", "Twelve PLN and 50/100"},
+		{5, "PLN", "en", "Zero PLN and 05/100"},
+		{5, "XYZ", "en", "Zero XYZ and 05/100"},
+		{-1000, "PLN", "es", "Menos diez PLN"},
+		{1005, "BHD", "de", "Ein Dinar und fünf Fils"},
+		{123456, "EUR", "de", "Eintausendzweihundertvierunddreißig Euro und sechsundfünfzig Cent"},
+		{100, "EUR", "DE-at", "Ein Euro"},
+		{2100, "EUR", "de", "Einundzwanzig Euro"},
+		{10100, "EUR", "de", "Einhundertein Euro"},
+		{10110000, "CHF", "de", "Einhunderteintausendeinhundert Franken"},
+		{200000100, "EUR", "de", "Zwei Millionen ein Euro"},
+		{100000000, "GBP", "de", "Eine Million Pfund"},
+		{2, "GBP", "de", "Null Pfund und zwei Pence"},
+		{123456, "EUR", "fr", "Mille deux cent trente-quatre euros et cinquante-six centimes"},
+		{100, "EUR", "fr", "Un euro"},
+		{1, "EUR", "fr", "Zéro euro et un centime"},
+		{2100, "GBP", "fr", "Vingt et une livres"},
+		{8100, "GBP", "fr", "Quatre-vingt-une livres"},
+		{7100, "EUR", "fr", "Soixante et onze euros"},
+		{8000, "EUR", "fr", "Quatre-vingts euros"},
+		{8000000, "EUR", "fr", "Quatre-vingt mille euros"},
+		{20000, "EUR", "fr", "Deux cents euros"},
+		{20100, "EUR", "fr", "Deux cent un euros"},
+		{200000000, "EUR", "fr", "Deux millions d'euros"},
+		{100000000, "USD", "fr", "Un million de dollars"},
+		{-9799, "CHF", "fr", "Moins quatre-vingt-dix-sept francs et quatre-vingt-dix-neuf centimes"},
+		{123456, "EUR", "es", "Mil doscientos treinta y cuatro euros con cincuenta y seis céntimos"},
+		{100, "EUR", "es", "Un euro"},
+		{2100, "USD", "es", "Veintiún dólares"},
+		{3100, "GBP", "es", "Treinta y una libras"},
+		{20000, "GBP", "es", "Doscientas libras"},
+		{10000, "EUR", "es", "Cien euros"},
+		{10100, "EUR", "es", "Ciento un euros"},
+		{2100000, "EUR", "es", "Veintiún mil euros"},
+		{100000000, "EUR", "es", "Un millón de euros"},
+		{100000000000, "EUR", "es", "Mil millones de euros"},
+		{100000000000100, "EUR", "es", "Un billón un euros"},
+		{1, "EUR", "es", "Cero euros con un céntimo"},
+		{500, "JPY", "es", "Quinientos yenes"},
+	}
+
+	for _, tc := range tcs {
+		s, err := New(tc.amount, tc.code).InWords(tc.lang)
+		if err != nil || s != tc.expected {
+			t.Errorf("Expected %d %s in %s to be %q got %q %v", tc.amount, tc.code, tc.lang, tc.expected, s, err)
+		}
+	}
+}
+
+func TestMoney_InWordsLimits(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		lang     string
+		expected string
+	}{
+		{-9223372036854775808, "en", "Minus nine quintillion two hundred twenty-three quadrillion three hundred " +
+			"seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five " +
+			"thousand eight hundred eight yen"},
+		{9000000000000000000, "de", "Neun Trillionen Yen"},
+		{9000000000000000000, "fr", "Neuf trillions de yens"},
+		{9000000000000000000, "es", "Nueve trillones de yenes"},
+	}
+
+	for _, tc := range tcs {
+		s, err := New(tc.amount, "JPY").InWords(tc.lang)
+		if err != nil || s != tc.expected {
+			t.Errorf("Expected %d in %s to be %q got %q %v", tc.amount, tc.lang, tc.expected, s, err)
+		}
+	}
+}
+
+func TestMoney_InWordsUnsupportedLanguage(t *testing.T) {
+	_, err := New(100, "EUR").InWords("it")
+	if !errors.Is(err, ErrUnsupportedLanguage) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedLanguage, err)
+	}
+}
+
+func TestMoney_InWords2(t *testing.T) {
+	for i, m := range []*Money{nil, {}, {AmountData: &Amount{100}}, {CurrencyData: &Currency{Code: "EUR"}}} {
+		if s, err := m.InWords("en"); s != "" || !errors.Is(err, ErrInvalidMoney) {
+			t.Errorf("%d: expected %v got %q %v", i, ErrInvalidMoney, s, err)
+		}
+	}
+}
+
+func TestAddUnitNames(t *testing.T) {
+	err := AddUnitNames("fr-CA", "sek", UnitName{One: "couronne", Other: "couronnes", Feminine: true},
+		UnitName{One: "öre", Other: "öre"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := New(2101, "SEK").InWords("fr")
+	expected := "Vingt et une couronnes et un öre"
+	if err != nil || s != expected {
+		t.Errorf("Expected %q got %q %v", expected, s, err)
+	}
+
+	if err := AddUnitNames("xx", "SEK", UnitName{}, UnitName{}); !errors.Is(err, ErrUnsupportedLanguage) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedLanguage, err)
+	}
+}
+
+func TestAddUnitNames2(t *testing.T) {
+	tcs := []struct {
+		major UnitName
+		minor UnitName
+	}{
+		{UnitName{}, UnitName{}},
+		{UnitName{One: "krone"}, UnitName{}},
+		{UnitName{Other: "kroner"}, UnitName{}},
+		{UnitName{One: "krone", Other: "kroner"}, UnitName{One: "øre"}},
+		{UnitName{One: "krone", Other: "kroner"}, UnitName{Other: "øre"}},
+	}
+
+	for i, tc := range tcs {
+		if err := AddUnitNames("en", "NOK", tc.major, tc.minor); !errors.Is(err, ErrInvalidUnitName) {
+			t.Errorf("%d: expected %v got %v", i, ErrInvalidUnitName, err)
+		}
+	}
+
+	if _, ok := currencyWords["en"]["NOK"]; ok {
+		t.Errorf("Expected invalid unit names not to be added")
+	}
+}
+
+func TestAddUnitNames_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = AddUnitNames("de", "DKK", UnitName{One: "Krone", Other: "Kronen", Feminine: true}, UnitName{One: "Øre", Other: "Øre"})
+			_, _ = New(101, "DKK").InWords("de")
+		}()
+	}
+
+	wg.Wait()
+
+	s, err := New(101, "DKK").InWords("de")
+	expected := "Eine Krone und ein Øre"
+	if err != nil || s != expected {
+		t.Errorf("Expected %q got %q %v", expected, s, err)
+	}
+}
+
+func TestOfFrench(t *testing.T) {
+	tcs := []struct {
+		n        uint64
+		unit     string
+		expected string
+	}{
+		{2, "euros", "euros"},
+		{2000000, "euros", "d'euros"},
+		{2000000, "dollars", "de dollars"},
+		{2000001, "dollars", "dollars"},
+		{2000000, "", ""},
+	}
+
+	for _, tc := range tcs {
+		if r := ofFrench(tc.n, tc.unit); r != tc.expected {
+			t.Errorf("Expected %d %q to be %q got %q", tc.n, tc.unit, tc.expected, r)
+		}
+	}
+}